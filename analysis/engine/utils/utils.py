@@ -27,6 +27,9 @@ from pathlib import Path
 from analysis.default_config import TUNING_DATA_PATH, TUNING_DATA_DIRS
 from analysis.engine.config import EngineConfig
 
+# the value of the conditional knob in the params when it is inactive
+INACTIVE_VALUE = "inactive"
+
 
 def read_from_csv(path):
     """read data from csv"""
@@ -136,6 +139,47 @@ def get_tuning_options(param):
     return param
 
 
+def get_inactive_knobs(knobs, values):
+    """get the names of the knobs whose condition on the parent knob is not satisfied"""
+    index = {knob["name"]: i for i, knob in enumerate(knobs)}
+    inactive = set()
+    for knob in knobs:
+        current = knob
+        for _ in range(len(knobs)):
+            parent = current.get("parent")
+            if not parent:
+                break
+            if parent not in index or \
+                    not match_condition(values[index[parent]], current.get("condition") or []):
+                inactive.add(knob["name"])
+                break
+            current = knobs[index[parent]]
+    return inactive
+
+
+def match_condition(value, condition):
+    """check the value of the parent knob is in the condition"""
+    for item in condition:
+        if str(value) == item:
+            return True
+        try:
+            if abs(float(value) - float(item)) < 1e-6:
+                return True
+        except (TypeError, ValueError):
+            continue
+    return False
+
+
+def get_inactive_value(knob):
+    """get the fixed value of the knob when it is inactive"""
+    if knob["dtype"] == "string":
+        return knob["options"][0]
+    values = knob["range"] if knob.get("range") else knob["items"]
+    if knob["dtype"] == "int":
+        return int(values[0])
+    return float(values[0])
+
+
 def get_multiple_res(values, mul):
     """get string multiple result"""
     res = ""
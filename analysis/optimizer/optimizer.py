@@ -171,7 +171,9 @@ class Optimizer(multiprocessing.Process):
         for p_nob in self.knobs:
             if p_nob['name'] not in kev.keys():
                 raise ValueError(f"the param {p_nob['name']} is not in the x0 ref")
-            if p_nob['dtype'] == 'int':
+            if kev[p_nob['name']] == utils.INACTIVE_VALUE:
+                x_each.append(utils.get_inactive_value(p_nob))
+            elif p_nob['dtype'] == 'int':
                 x_each.append(int(kev[p_nob['name']]))
             elif p_nob['dtype'] == 'float':
                 x_each.append(float(kev[p_nob['name']]))
@@ -200,10 +202,23 @@ class Optimizer(multiprocessing.Process):
             ref_x = self._get_value_from_knobs(kev)
             if len(ref_x) != len(self.knobs):
                 raise ValueError("tuning parameter is not the same length with knobs")
-            list_ref_x.append(ref_x)
+            list_ref_x.append(self.canonical(ref_x))
         list_ref_y = [float(y) for y in self.y_ref]
         return (list_ref_x, list_ref_y)
 
+    def canonical(self, point):
+        """set the values of the inactive knobs to the fixed values, so that the
+        points which differ only in the inactive knobs are the same"""
+        inactive = utils.get_inactive_knobs(self.knobs, point)
+        return [utils.get_inactive_value(knob) if knob['name'] in inactive else point[i]
+                for i, knob in enumerate(self.knobs)]
+
+    def mark_inactive(self, point):
+        """get the params of the point, the values of the inactive knobs are inactive"""
+        inactive = utils.get_inactive_knobs(self.knobs, point)
+        return {knob['name']: utils.INACTIVE_VALUE if knob['name'] in inactive else point[i]
+                for i, knob in enumerate(self.knobs)}
+
     def run(self):
         """start the tuning process"""
 
@@ -211,8 +226,8 @@ class Optimizer(multiprocessing.Process):
             """objective method receive the benchmark result and send the next parameters"""
             iter_result = {}
             option = []
+            params.update(self.mark_inactive(var))
             for i, knob in enumerate(self.knobs):
-                params[knob['name']] = var[i]
                 if knob['dtype'] == 'string':
                     option.append(knob['options'].index(var[i]))
                 else:
@@ -296,7 +311,7 @@ class Optimizer(multiprocessing.Process):
                     ret = optimizer.tell(ref_x, ref_y)
 
                 for i in range(n_calls):
-                    next_x = optimizer.ask()
+                    next_x = self.canonical(optimizer.ask())
                     LOGGER.info("next_x: %s", next_x)
                     LOGGER.info("Running performance evaluation.......")
                     next_y = objective(next_x)
@@ -377,9 +392,9 @@ class Optimizer(multiprocessing.Process):
             self.child_conn.send(Exception("Unexpected Error:", repr(err)))
             return None
 
-        for i, knob in enumerate(self.knobs):
-            if estimator is not None:
-                params[knob['name']] = ret.x[i]
+        if estimator is not None:
+            params = self.mark_inactive(ret.x)
+        for knob in self.knobs:
             if self.engine != 'gridsearch':
                 labels.append(knob['name'])
 
@@ -36,6 +36,10 @@ const (
 	TuningMessage_Threshold        TuningMessageStatus = 8
 	TuningMessage_JobCreate        TuningMessageStatus = 9
 	TuningMessage_GetInitialConfig TuningMessageStatus = 10
+	TuningMessage_Apply            TuningMessageStatus = 11
+	TuningMessage_Export           TuningMessageStatus = 12
+	TuningMessage_ApplyFailed      TuningMessageStatus = 13
+	TuningMessage_DryRun           TuningMessageStatus = 14
 )
 
 var TuningMessageStatus_name = map[int32]string{
@@ -50,6 +54,10 @@ var TuningMessageStatus_name = map[int32]string{
 	8:  "Threshold",
 	9:  "JobCreate",
 	10: "GetInitialConfig",
+	11: "Apply",
+	12: "Export",
+	13: "ApplyFailed",
+	14: "DryRun",
 }
 
 var TuningMessageStatus_value = map[string]int32{
@@ -64,6 +72,10 @@ var TuningMessageStatus_value = map[string]int32{
 	"Threshold":        8,
 	"JobCreate":        9,
 	"GetInitialConfig": 10,
+	"Apply":            11,
+	"Export":           12,
+	"ApplyFailed":      13,
+	"DryRun":           14,
 }
 
 func (x TuningMessageStatus) String() string {
@@ -71,7 +83,7 @@ func (x TuningMessageStatus) String() string {
 }
 
 func (TuningMessageStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_744bf7a47b381504, []int{10, 0}
+	return fileDescriptor_744bf7a47b381504, []int{11, 0}
 }
 
 type ListMessage struct {
@@ -538,6 +550,53 @@ func (m *TrainMessage) GetOutputPath() string {
 	return ""
 }
 
+type DetectMessage struct {
+	AppName              string   `protobuf:"bytes,1,opt,name=AppName,proto3" json:"AppName,omitempty"`
+	DetectPath           string   `protobuf:"bytes,2,opt,name=DetectPath,proto3" json:"DetectPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DetectMessage) Reset()         { *m = DetectMessage{} }
+func (m *DetectMessage) String() string { return proto.CompactTextString(m) }
+func (*DetectMessage) ProtoMessage()    {}
+func (*DetectMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{8}
+}
+
+func (m *DetectMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectMessage.Unmarshal(m, b)
+}
+func (m *DetectMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectMessage.Marshal(b, m, deterministic)
+}
+func (m *DetectMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectMessage.Merge(m, src)
+}
+func (m *DetectMessage) XXX_Size() int {
+	return xxx_messageInfo_DetectMessage.Size(m)
+}
+func (m *DetectMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectMessage proto.InternalMessageInfo
+
+func (m *DetectMessage) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+func (m *DetectMessage) GetDetectPath() string {
+	if m != nil {
+		return m.DetectPath
+	}
+	return ""
+}
+
 type DefineMessage struct {
 	ServiceType          string   `protobuf:"bytes,1,opt,name=ServiceType,proto3" json:"ServiceType,omitempty"`
 	ApplicationName      string   `protobuf:"bytes,2,opt,name=ApplicationName,proto3" json:"ApplicationName,omitempty"`
@@ -552,7 +611,7 @@ func (m *DefineMessage) Reset()         { *m = DefineMessage{} }
 func (m *DefineMessage) String() string { return proto.CompactTextString(m) }
 func (*DefineMessage) ProtoMessage()    {}
 func (*DefineMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_744bf7a47b381504, []int{8}
+	return fileDescriptor_744bf7a47b381504, []int{9}
 }
 
 func (m *DefineMessage) XXX_Unmarshal(b []byte) error {
@@ -614,7 +673,7 @@ func (m *ScheduleMessage) Reset()         { *m = ScheduleMessage{} }
 func (m *ScheduleMessage) String() string { return proto.CompactTextString(m) }
 func (*ScheduleMessage) ProtoMessage()    {}
 func (*ScheduleMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_744bf7a47b381504, []int{9}
+	return fileDescriptor_744bf7a47b381504, []int{10}
 }
 
 func (m *ScheduleMessage) XXX_Unmarshal(b []byte) error {
@@ -673,6 +732,16 @@ type TuningMessage struct {
 	EvalFluctuation      float64             `protobuf:"fixed64,14,opt,name=EvalFluctuation,proto3" json:"EvalFluctuation,omitempty"`
 	FeatureSelector      string              `protobuf:"bytes,15,opt,name=FeatureSelector,proto3" json:"FeatureSelector,omitempty"`
 	InitialConfig        string              `protobuf:"bytes,16,opt,name=InitialConfig,proto3" json:"InitialConfig,omitempty"`
+	Id                   string              `protobuf:"bytes,17,opt,name=Id,proto3" json:"Id,omitempty"`
+	MaxDuration          int64               `protobuf:"varint,18,opt,name=MaxDuration,proto3" json:"MaxDuration,omitempty"`
+	TargetImprovement    float64             `protobuf:"fixed64,19,opt,name=TargetImprovement,proto3" json:"TargetImprovement,omitempty"`
+	PlateauIters         int32               `protobuf:"varint,20,opt,name=PlateauIters,proto3" json:"PlateauIters,omitempty"`
+	PlateauImprovement   float64             `protobuf:"fixed64,21,opt,name=PlateauImprovement,proto3" json:"PlateauImprovement,omitempty"`
+	ObjectiveMode        string              `protobuf:"bytes,22,opt,name=ObjectiveMode,proto3" json:"ObjectiveMode,omitempty"`
+	Objectives           []string            `protobuf:"bytes,23,rep,name=Objectives,proto3" json:"Objectives,omitempty"`
+	WarmStart            string              `protobuf:"bytes,24,opt,name=WarmStart,proto3" json:"WarmStart,omitempty"`
+	WarmStartData        []byte              `protobuf:"bytes,25,opt,name=WarmStartData,proto3" json:"WarmStartData,omitempty"`
+	WarmStartWeight      float64             `protobuf:"fixed64,26,opt,name=WarmStartWeight,proto3" json:"WarmStartWeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -682,7 +751,7 @@ func (m *TuningMessage) Reset()         { *m = TuningMessage{} }
 func (m *TuningMessage) String() string { return proto.CompactTextString(m) }
 func (*TuningMessage) ProtoMessage()    {}
 func (*TuningMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_744bf7a47b381504, []int{10}
+	return fileDescriptor_744bf7a47b381504, []int{11}
 }
 
 func (m *TuningMessage) XXX_Unmarshal(b []byte) error {
@@ -787,7 +856,6 @@ func (m *TuningMessage) GetSplitCount() int32 {
 	return 0
 }
 
-
 func (m *TuningMessage) GetFeatureFilterCount() int32 {
 	if m != nil {
 		return m.FeatureFilterCount
@@ -816,12 +884,83 @@ func (m *TuningMessage) GetInitialConfig() string {
 	return ""
 }
 
+func (m *TuningMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TuningMessage) GetMaxDuration() int64 {
+	if m != nil {
+		return m.MaxDuration
+	}
+	return 0
+}
+
+func (m *TuningMessage) GetTargetImprovement() float64 {
+	if m != nil {
+		return m.TargetImprovement
+	}
+	return 0
+}
+
+func (m *TuningMessage) GetPlateauIters() int32 {
+	if m != nil {
+		return m.PlateauIters
+	}
+	return 0
+}
+
+func (m *TuningMessage) GetPlateauImprovement() float64 {
+	if m != nil {
+		return m.PlateauImprovement
+	}
+	return 0
+}
+
+func (m *TuningMessage) GetObjectiveMode() string {
+	if m != nil {
+		return m.ObjectiveMode
+	}
+	return ""
+}
+
+func (m *TuningMessage) GetObjectives() []string {
+	if m != nil {
+		return m.Objectives
+	}
+	return nil
+}
+
+func (m *TuningMessage) GetWarmStart() string {
+	if m != nil {
+		return m.WarmStart
+	}
+	return ""
+}
+
+func (m *TuningMessage) GetWarmStartData() []byte {
+	if m != nil {
+		return m.WarmStartData
+	}
+	return nil
+}
+
+func (m *TuningMessage) GetWarmStartWeight() float64 {
+	if m != nil {
+		return m.WarmStartWeight
+	}
+	return 0
+}
+
 type TuningHistory struct {
 	BaseEval             string   `protobuf:"bytes,1,opt,name=BaseEval,proto3" json:"BaseEval,omitempty"`
 	MinEval              string   `protobuf:"bytes,2,opt,name=MinEval,proto3" json:"MinEval,omitempty"`
 	SumEval              string   `protobuf:"bytes,3,opt,name=SumEval,proto3" json:"SumEval,omitempty"`
 	TotalTime            int64    `protobuf:"varint,4,opt,name=TotalTime,proto3" json:"TotalTime,omitempty"`
 	Starts               int32    `protobuf:"varint,5,opt,name=Starts,proto3" json:"Starts,omitempty"`
+	Status               string   `protobuf:"bytes,6,opt,name=Status,proto3" json:"Status,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -831,7 +970,7 @@ func (m *TuningHistory) Reset()         { *m = TuningHistory{} }
 func (m *TuningHistory) String() string { return proto.CompactTextString(m) }
 func (*TuningHistory) ProtoMessage()    {}
 func (*TuningHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_744bf7a47b381504, []int{11}
+	return fileDescriptor_744bf7a47b381504, []int{12}
 }
 
 func (m *TuningHistory) XXX_Unmarshal(b []byte) error {
@@ -886,49 +1025,325 @@ func (m *TuningHistory) GetStarts() int32 {
 	}
 	return 0
 }
-type DetectMessage struct {
-	AppName              string   `protobuf:"bytes,1,opt,name=AppName,proto3" json:"AppName,omitempty"`
-	DetectPath           string   `protobuf:"bytes,2,opt,name=DetectPath,proto3" json:"DetectPath,omitempty"`
+
+func (m *TuningHistory) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type JobInfo struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	Project              string   `protobuf:"bytes,3,opt,name=Project,proto3" json:"Project,omitempty"`
+	Status               string   `protobuf:"bytes,4,opt,name=Status,proto3" json:"Status,omitempty"`
+	StartTime            string   `protobuf:"bytes,5,opt,name=StartTime,proto3" json:"StartTime,omitempty"`
+	Iteration            int32    `protobuf:"varint,6,opt,name=Iteration,proto3" json:"Iteration,omitempty"`
+	MaxIteration         int32    `protobuf:"varint,7,opt,name=MaxIteration,proto3" json:"MaxIteration,omitempty"`
+	Knobs                string   `protobuf:"bytes,8,opt,name=Knobs,proto3" json:"Knobs,omitempty"`
+	BestEval             string   `protobuf:"bytes,9,opt,name=BestEval,proto3" json:"BestEval,omitempty"`
+	ElapsedTime          int64    `protobuf:"varint,10,opt,name=ElapsedTime,proto3" json:"ElapsedTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DetectMessage) Reset()         { *m = DetectMessage{} }
-func (m *DetectMessage) String() string { return proto.CompactTextString(m) }
-func (*DetectMessage) ProtoMessage()    {}
-func (*DetectMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_744bf7a47b381504, []int{12}
+func (m *JobInfo) Reset()         { *m = JobInfo{} }
+func (m *JobInfo) String() string { return proto.CompactTextString(m) }
+func (*JobInfo) ProtoMessage()    {}
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{13}
 }
 
-func (m *DetectMessage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DetectMessage.Unmarshal(m, b)
+func (m *JobInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobInfo.Unmarshal(m, b)
 }
-func (m *DetectMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DetectMessage.Marshal(b, m, deterministic)
+func (m *JobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobInfo.Marshal(b, m, deterministic)
 }
-func (m *DetectMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DetectMessage.Merge(m, src)
+func (m *JobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobInfo.Merge(m, src)
 }
-func (m *DetectMessage) XXX_Size() int {
-	return xxx_messageInfo_DetectMessage.Size(m)
+func (m *JobInfo) XXX_Size() int {
+	return xxx_messageInfo_JobInfo.Size(m)
 }
-func (m *DetectMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_DetectMessage.DiscardUnknown(m)
+func (m *JobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DetectMessage proto.InternalMessageInfo
+var xxx_messageInfo_JobInfo proto.InternalMessageInfo
 
-func (m *DetectMessage) GetAppName() string {
+func (m *JobInfo) GetId() string {
 	if m != nil {
-		return m.AppName
+		return m.Id
 	}
 	return ""
 }
 
-func (m *DetectMessage) GetDetectPath() string {
+func (m *JobInfo) GetType() string {
 	if m != nil {
-		return m.DetectPath
+		return m.Type
+	}
+	return ""
+}
+
+func (m *JobInfo) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
+
+func (m *JobInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *JobInfo) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *JobInfo) GetIteration() int32 {
+	if m != nil {
+		return m.Iteration
+	}
+	return 0
+}
+
+func (m *JobInfo) GetMaxIteration() int32 {
+	if m != nil {
+		return m.MaxIteration
+	}
+	return 0
+}
+
+func (m *JobInfo) GetKnobs() string {
+	if m != nil {
+		return m.Knobs
+	}
+	return ""
+}
+
+func (m *JobInfo) GetBestEval() string {
+	if m != nil {
+		return m.BestEval
+	}
+	return ""
+}
+
+func (m *JobInfo) GetElapsedTime() int64 {
+	if m != nil {
+		return m.ElapsedTime
+	}
+	return 0
+}
+
+type JobControl struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	Option               string   `protobuf:"bytes,3,opt,name=Option,proto3" json:"Option,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobControl) Reset()         { *m = JobControl{} }
+func (m *JobControl) String() string { return proto.CompactTextString(m) }
+func (*JobControl) ProtoMessage()    {}
+func (*JobControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{14}
+}
+
+func (m *JobControl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JobControl.Unmarshal(m, b)
+}
+func (m *JobControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JobControl.Marshal(b, m, deterministic)
+}
+func (m *JobControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobControl.Merge(m, src)
+}
+func (m *JobControl) XXX_Size() int {
+	return xxx_messageInfo_JobControl.Size(m)
+}
+func (m *JobControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobControl proto.InternalMessageInfo
+
+func (m *JobControl) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *JobControl) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *JobControl) GetOption() string {
+	if m != nil {
+		return m.Option
+	}
+	return ""
+}
+
+type ReportMessage struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Format               string   `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportMessage) Reset()         { *m = ReportMessage{} }
+func (m *ReportMessage) String() string { return proto.CompactTextString(m) }
+func (*ReportMessage) ProtoMessage()    {}
+func (*ReportMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{15}
+}
+
+func (m *ReportMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportMessage.Unmarshal(m, b)
+}
+func (m *ReportMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportMessage.Marshal(b, m, deterministic)
+}
+func (m *ReportMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportMessage.Merge(m, src)
+}
+func (m *ReportMessage) XXX_Size() int {
+	return xxx_messageInfo_ReportMessage.Size(m)
+}
+func (m *ReportMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportMessage proto.InternalMessageInfo
+
+func (m *ReportMessage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ReportMessage) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+type BenchmarkMessage struct {
+	Script               string   `protobuf:"bytes,1,opt,name=Script,proto3" json:"Script,omitempty"`
+	Timeout              int64    `protobuf:"varint,2,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BenchmarkMessage) Reset()         { *m = BenchmarkMessage{} }
+func (m *BenchmarkMessage) String() string { return proto.CompactTextString(m) }
+func (*BenchmarkMessage) ProtoMessage()    {}
+func (*BenchmarkMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{16}
+}
+
+func (m *BenchmarkMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BenchmarkMessage.Unmarshal(m, b)
+}
+func (m *BenchmarkMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BenchmarkMessage.Marshal(b, m, deterministic)
+}
+func (m *BenchmarkMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BenchmarkMessage.Merge(m, src)
+}
+func (m *BenchmarkMessage) XXX_Size() int {
+	return xxx_messageInfo_BenchmarkMessage.Size(m)
+}
+func (m *BenchmarkMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BenchmarkMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BenchmarkMessage proto.InternalMessageInfo
+
+func (m *BenchmarkMessage) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *BenchmarkMessage) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type BenchmarkOutput struct {
+	Output               []byte   `protobuf:"bytes,1,opt,name=Output,proto3" json:"Output,omitempty"`
+	Done                 bool     `protobuf:"varint,2,opt,name=Done,proto3" json:"Done,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BenchmarkOutput) Reset()         { *m = BenchmarkOutput{} }
+func (m *BenchmarkOutput) String() string { return proto.CompactTextString(m) }
+func (*BenchmarkOutput) ProtoMessage()    {}
+func (*BenchmarkOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{17}
+}
+
+func (m *BenchmarkOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BenchmarkOutput.Unmarshal(m, b)
+}
+func (m *BenchmarkOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BenchmarkOutput.Marshal(b, m, deterministic)
+}
+func (m *BenchmarkOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BenchmarkOutput.Merge(m, src)
+}
+func (m *BenchmarkOutput) XXX_Size() int {
+	return xxx_messageInfo_BenchmarkOutput.Size(m)
+}
+func (m *BenchmarkOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_BenchmarkOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BenchmarkOutput proto.InternalMessageInfo
+
+func (m *BenchmarkOutput) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *BenchmarkOutput) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *BenchmarkOutput) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
@@ -943,84 +1358,125 @@ func init() {
 	proto.RegisterType((*ProfileLog)(nil), "profile.ProfileLog")
 	proto.RegisterType((*CollectFlag)(nil), "profile.CollectFlag")
 	proto.RegisterType((*TrainMessage)(nil), "profile.TrainMessage")
+	proto.RegisterType((*DetectMessage)(nil), "profile.DetectMessage")
 	proto.RegisterType((*DefineMessage)(nil), "profile.DefineMessage")
 	proto.RegisterType((*ScheduleMessage)(nil), "profile.ScheduleMessage")
 	proto.RegisterType((*TuningMessage)(nil), "profile.TuningMessage")
 	proto.RegisterType((*TuningHistory)(nil), "profile.TuningHistory")
-	proto.RegisterType((*DetectMessage)(nil), "profile.DetectMessage")
+	proto.RegisterType((*JobInfo)(nil), "profile.JobInfo")
+	proto.RegisterType((*JobControl)(nil), "profile.JobControl")
+	proto.RegisterType((*ReportMessage)(nil), "profile.ReportMessage")
+	proto.RegisterType((*BenchmarkMessage)(nil), "profile.BenchmarkMessage")
+	proto.RegisterType((*BenchmarkOutput)(nil), "profile.BenchmarkOutput")
 }
 
 func init() { proto.RegisterFile("profile.proto", fileDescriptor_744bf7a47b381504) }
 
 var fileDescriptor_744bf7a47b381504 = []byte{
-	// 1070 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcb, 0x6e, 0xe3, 0x36,
-	0x17, 0x8e, 0xed, 0xf8, 0x76, 0xec, 0x24, 0xfa, 0xf9, 0x4f, 0x03, 0x21, 0xe8, 0x14, 0x81, 0xd0,
-	0x45, 0xd0, 0x45, 0x10, 0x64, 0x8a, 0x5e, 0x10, 0xcc, 0x14, 0x8e, 0x93, 0xb4, 0x0e, 0x92, 0xe9,
-	0x40, 0xce, 0xa0, 0xb3, 0x65, 0x24, 0x46, 0x26, 0x4c, 0x93, 0x02, 0x45, 0xa7, 0x70, 0x9f, 0xa3,
-	0x5d, 0xf4, 0x6d, 0xba, 0xe9, 0xe3, 0xf4, 0x1d, 0x0a, 0x5e, 0x74, 0x71, 0x46, 0x2e, 0xda, 0xec,
-	0xf4, 0x7d, 0xe7, 0xc2, 0xc3, 0xc3, 0x8f, 0x3c, 0x82, 0x9d, 0x54, 0x8a, 0x07, 0xca, 0xc8, 0x71,
-	0x2a, 0x85, 0x12, 0xa8, 0xeb, 0x60, 0xb0, 0x80, 0xc1, 0x0d, 0xcd, 0xd4, 0x2d, 0xc9, 0x32, 0x9c,
-	0x10, 0x14, 0xc0, 0xf0, 0x27, 0x21, 0xe7, 0x4c, 0xe0, 0xf8, 0x6e, 0x95, 0x12, 0xbf, 0x71, 0xd8,
-	0x38, 0xea, 0x87, 0x6b, 0x9c, 0xf6, 0x79, 0x67, 0xa3, 0xdf, 0xe2, 0x05, 0xc9, 0xfc, 0xa6, 0xf5,
-	0xa9, 0x72, 0x68, 0x1f, 0x3a, 0xa3, 0x48, 0xd1, 0x47, 0xe2, 0xb7, 0x8c, 0xd5, 0xa1, 0xe0, 0x0c,
-	0x06, 0xce, 0x6f, 0xc2, 0x1f, 0x04, 0x42, 0xb0, 0xad, 0xfd, 0xdd, 0x32, 0xe6, 0x1b, 0xf9, 0xd0,
-	0x1d, 0x0b, 0xae, 0x08, 0x57, 0x26, 0xf3, 0x30, 0xcc, 0x61, 0x90, 0xc0, 0xde, 0x88, 0x63, 0xb6,
-	0xca, 0x68, 0x96, 0xd7, 0x5b, 0x97, 0xe0, 0x05, 0xb4, 0x6f, 0x45, 0x4c, 0x98, 0x2b, 0xcc, 0x02,
-	0xf4, 0x05, 0x78, 0xe3, 0x19, 0x96, 0x38, 0x52, 0x44, 0xd2, 0x5f, 0xb0, 0xa2, 0x82, 0x9b, 0xda,
-	0x7a, 0xe1, 0x47, 0x7c, 0xf0, 0x12, 0x5a, 0xa3, 0x68, 0xae, 0x37, 0x31, 0x55, 0x58, 0x2d, 0x33,
-	0x97, 0xde, 0xa1, 0xe0, 0x03, 0xf4, 0x46, 0xd1, 0x7c, 0x3c, 0x23, 0xd1, 0xbc, 0xb6, 0x80, 0x32,
-	0xae, 0x59, 0x8d, 0x43, 0x87, 0x30, 0xb8, 0x20, 0x59, 0x24, 0x69, 0x5a, 0xac, 0xde, 0x0f, 0xab,
-	0x54, 0xf0, 0x01, 0xc0, 0xb5, 0xe7, 0x46, 0x24, 0x68, 0x17, 0x9a, 0x93, 0xd8, 0x64, 0x6e, 0x85,
-	0xcd, 0x49, 0x8c, 0x3e, 0x85, 0x7e, 0xde, 0xbc, 0xd8, 0xa5, 0x2e, 0x09, 0x6d, 0xbd, 0xa3, 0x0b,
-	0x92, 0x29, 0xbc, 0x48, 0x5d, 0xee, 0x92, 0x08, 0xfe, 0x6c, 0xc0, 0x60, 0x2c, 0x18, 0x23, 0x91,
-	0xba, 0x62, 0x38, 0x41, 0x07, 0xd0, 0x9b, 0x70, 0x45, 0xe4, 0x23, 0x66, 0x6e, 0x85, 0x02, 0x6b,
-	0xdb, 0xc5, 0x52, 0xda, 0x16, 0x35, 0xad, 0x2d, 0xc7, 0xda, 0x96, 0x8b, 0xc1, 0x2d, 0x52, 0x60,
-	0xf4, 0x19, 0xc0, 0x8f, 0x4b, 0x95, 0x2e, 0xd5, 0x3b, 0xac, 0x66, 0xfe, 0xb6, 0xb1, 0x56, 0x18,
-	0x7d, 0x30, 0xe7, 0x4c, 0x44, 0x73, 0xbf, 0x6d, 0x0f, 0xc6, 0x00, 0x7d, 0xde, 0x6f, 0x89, 0xfa,
-	0x59, 0xc8, 0xb9, 0xdf, 0x31, 0x7c, 0x0e, 0x75, 0x6f, 0x8d, 0x08, 0xbb, 0xb6, 0xb7, 0xfa, 0x3b,
-	0xb8, 0x86, 0xe1, 0x9d, 0xc4, 0x94, 0xe7, 0x02, 0xd0, 0xb5, 0x62, 0x85, 0xcd, 0x8a, 0xf6, 0x0c,
-	0x0a, 0xfc, 0xa4, 0x9e, 0xe6, 0xd3, 0x7a, 0x82, 0xdf, 0x1b, 0xb0, 0x73, 0x41, 0x1e, 0x28, 0x27,
-	0x79, 0xb6, 0x43, 0x18, 0x4c, 0x89, 0x7c, 0xa4, 0x11, 0xa9, 0xa8, 0xbf, 0x4a, 0xa1, 0x23, 0xd8,
-	0x1b, 0xa5, 0x29, 0xa3, 0x91, 0x69, 0x87, 0x39, 0x7a, 0x9b, 0xf8, 0x29, 0xad, 0xaf, 0xc9, 0x34,
-	0x22, 0x1c, 0x4b, 0x2a, 0x8c, 0x9b, 0xed, 0xd6, 0x1a, 0x57, 0xd5, 0xfa, 0xf6, 0xba, 0xd6, 0xa7,
-	0xb0, 0x37, 0x8d, 0x66, 0x24, 0x5e, 0xb2, 0xa2, 0x38, 0x0f, 0x5a, 0xa3, 0x34, 0x75, 0x45, 0xe9,
-	0xcf, 0xa2, 0x41, 0xcd, 0xb2, 0x41, 0xba, 0x21, 0x53, 0x25, 0xb1, 0x22, 0xc9, 0x2a, 0x3f, 0xa0,
-	0x1c, 0x07, 0x7f, 0x6d, 0xc3, 0xce, 0xdd, 0x92, 0x53, 0x9e, 0x54, 0xee, 0x0f, 0xaf, 0xc8, 0x97,
-	0x3b, 0xf9, 0x12, 0x9e, 0x50, 0x9e, 0xe7, 0x75, 0x48, 0x17, 0x1b, 0xb9, 0x62, 0x5b, 0xb6, 0x58,
-	0x07, 0xd1, 0x2b, 0x68, 0x67, 0x0a, 0x2b, 0x62, 0x36, 0xb1, 0x7b, 0xfa, 0xf2, 0x38, 0x7f, 0x6c,
-	0xd6, 0x16, 0x3b, 0xce, 0xcc, 0x35, 0x08, 0xad, 0xaf, 0xee, 0x4f, 0x88, 0x79, 0x2c, 0x16, 0x53,
-	0x85, 0xa5, 0xca, 0x8c, 0x28, 0xda, 0xe1, 0x1a, 0x87, 0x4e, 0xe0, 0xff, 0x57, 0x04, 0xab, 0xa5,
-	0x24, 0x57, 0x94, 0x29, 0x22, 0x2f, 0x6d, 0x5d, 0x56, 0x27, 0x75, 0x26, 0x74, 0x0c, 0x68, 0x8d,
-	0x1e, 0xaf, 0x22, 0x66, 0x15, 0xd4, 0x0e, 0x6b, 0x2c, 0x1f, 0xf9, 0x4f, 0x14, 0x91, 0x99, 0xdf,
-	0xab, 0xf1, 0x37, 0x16, 0xdd, 0x84, 0x50, 0x5f, 0x29, 0xa9, 0xfc, 0xbe, 0x79, 0x3d, 0x72, 0x88,
-	0x3e, 0x87, 0x9d, 0x35, 0x7f, 0x1f, 0x8c, 0x7d, 0x9d, 0x44, 0x5f, 0x42, 0xdf, 0x36, 0xe5, 0x46,
-	0x24, 0xfe, 0xe0, 0xb0, 0x71, 0x34, 0x38, 0xdd, 0x7f, 0xd2, 0xae, 0x1f, 0x68, 0xa6, 0x84, 0x5c,
-	0x85, 0xa5, 0xa3, 0x56, 0xf2, 0x34, 0x65, 0x54, 0x8d, 0xc5, 0x92, 0x2b, 0x7f, 0x68, 0xaa, 0xab,
-	0x30, 0xc1, 0xaf, 0x0d, 0xe8, 0xd8, 0xee, 0xa2, 0x01, 0x74, 0xaf, 0xc5, 0xfd, 0x84, 0x53, 0xe5,
-	0x6d, 0xa1, 0x5d, 0x80, 0x6b, 0x71, 0xef, 0x2a, 0xf4, 0x1a, 0x68, 0x07, 0xfa, 0xe7, 0x84, 0x47,
-	0xb3, 0x5b, 0x2c, 0xe7, 0x5e, 0x53, 0xfb, 0x6a, 0x9b, 0x90, 0xc4, 0x6b, 0x21, 0x80, 0xce, 0x25,
-	0x8f, 0x29, 0x4f, 0xbc, 0x6d, 0x6d, 0xb8, 0xa0, 0x59, 0xca, 0xf0, 0xca, 0x6b, 0xeb, 0x24, 0xd3,
-	0x15, 0x8f, 0xc6, 0x82, 0x3f, 0xd0, 0xc4, 0xeb, 0x68, 0xc7, 0x0b, 0xa2, 0x30, 0x65, 0x5e, 0x57,
-	0x27, 0xbc, 0x9b, 0x49, 0x92, 0xcd, 0x04, 0x8b, 0xbd, 0x9e, 0x86, 0xd7, 0xe2, 0x7e, 0x2c, 0x09,
-	0x56, 0xc4, 0xeb, 0x07, 0xbf, 0x35, 0x72, 0xbd, 0xb9, 0x3d, 0x69, 0x75, 0x9e, 0xe3, 0x8c, 0x5c,
-	0xe6, 0xcf, 0x4e, 0x3f, 0x2c, 0xb0, 0x6e, 0xed, 0x2d, 0xe5, 0xc6, 0x64, 0x85, 0x97, 0x43, 0x6d,
-	0x99, 0x2e, 0x17, 0xc6, 0x62, 0x25, 0x9d, 0x43, 0xf3, 0xe8, 0x09, 0x85, 0x99, 0x7e, 0xe8, 0x8c,
-	0xfa, 0x5a, 0x61, 0x49, 0xb8, 0x87, 0xb8, 0x14, 0x97, 0x43, 0xa7, 0x7f, 0x74, 0x8b, 0x77, 0xf6,
-	0x36, 0x91, 0xe8, 0x2b, 0xe8, 0x3a, 0x84, 0x5e, 0x14, 0x67, 0x51, 0x19, 0x53, 0x07, 0xff, 0x2b,
-	0xd8, 0xfc, 0xdd, 0x0f, 0xb6, 0x4e, 0x1a, 0xe8, 0x3b, 0x3d, 0x52, 0x48, 0x34, 0xd7, 0xcd, 0x7e,
-	0x56, 0x82, 0x33, 0xe8, 0xe5, 0x03, 0x0d, 0xf9, 0xa5, 0xcb, 0xfa, 0x8c, 0xdb, 0x14, 0xfc, 0x06,
-	0x3a, 0xb6, 0xb7, 0x68, 0xbf, 0xfe, 0xbe, 0x1d, 0x6c, 0xe0, 0x83, 0xad, 0xa3, 0x86, 0x89, 0x1f,
-	0xea, 0xc9, 0x5f, 0xbc, 0xde, 0xf5, 0x95, 0x97, 0x6c, 0xe5, 0x37, 0xc1, 0xac, 0xff, 0x1a, 0x76,
-	0xdf, 0xa7, 0x89, 0xc4, 0x31, 0x79, 0xd6, 0xde, 0x5f, 0xc3, 0x40, 0x9b, 0xff, 0x39, 0xb6, 0x96,
-	0x35, 0xe1, 0x23, 0x40, 0x26, 0x97, 0xfd, 0xaf, 0x78, 0x56, 0x05, 0x6f, 0x60, 0xcf, 0x79, 0x85,
-	0x82, 0xb1, 0x7b, 0x1c, 0xcd, 0xff, 0x5b, 0xfc, 0xb7, 0x00, 0x6e, 0xa2, 0xea, 0xc1, 0x58, 0x86,
-	0x56, 0xc6, 0xec, 0xa6, 0xd0, 0x6f, 0xa0, 0x67, 0xa6, 0x98, 0x3e, 0xbd, 0x4f, 0xca, 0x53, 0xaa,
-	0x0c, 0xb6, 0x4d, 0x91, 0x27, 0xfa, 0xf2, 0xe9, 0x91, 0x55, 0x39, 0xf5, 0xb5, 0x19, 0x76, 0x30,
-	0xac, 0x06, 0x06, 0x5b, 0xe8, 0x58, 0x47, 0x30, 0xa2, 0x36, 0x75, 0xa7, 0xc6, 0xff, 0x7d, 0x1a,
-	0xe3, 0x7f, 0xed, 0x7f, 0x06, 0xbd, 0x7c, 0x52, 0x55, 0x44, 0xfc, 0x64, 0x78, 0x6d, 0xda, 0xce,
-	0xd7, 0xd0, 0xfb, 0x9e, 0x70, 0x22, 0x37, 0x2f, 0x57, 0x1f, 0x78, 0xdf, 0x31, 0xff, 0xb1, 0xaf,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xa9, 0x73, 0x55, 0xd8, 0x0a, 0x00, 0x00,
+	// 1645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xdb, 0xc6,
+	0x12, 0xb6, 0x24, 0x5b, 0x3f, 0x23, 0xc9, 0x66, 0x36, 0x8e, 0x0f, 0x63, 0x9c, 0x1c, 0x18, 0xc4,
+	0xb9, 0x30, 0x0e, 0x0e, 0x0c, 0x23, 0x69, 0xd3, 0x1f, 0x23, 0x29, 0x14, 0xd9, 0x4e, 0xe5, 0xda,
+	0x49, 0x40, 0x39, 0x48, 0x6e, 0x57, 0xd4, 0x5a, 0x62, 0x45, 0x71, 0x89, 0xe5, 0xca, 0x8d, 0xfa,
+	0x1a, 0xbd, 0xea, 0x65, 0x1f, 0xa0, 0x57, 0x7d, 0x86, 0x5e, 0xf4, 0x25, 0xfa, 0x2c, 0xc5, 0xec,
+	0x2e, 0xa9, 0xa5, 0x2c, 0x05, 0x6d, 0xee, 0x34, 0xdf, 0xfc, 0xee, 0xec, 0xcc, 0xec, 0x50, 0xd0,
+	0x4e, 0x04, 0xbf, 0x09, 0x23, 0x76, 0x94, 0x08, 0x2e, 0x39, 0xa9, 0x19, 0xd2, 0x9b, 0x42, 0xf3,
+	0x32, 0x4c, 0xe5, 0x15, 0x4b, 0x53, 0x3a, 0x62, 0xc4, 0x83, 0xd6, 0x3b, 0x2e, 0x26, 0x11, 0xa7,
+	0xc3, 0xeb, 0x79, 0xc2, 0xdc, 0xd2, 0x41, 0xe9, 0xb0, 0xe1, 0x17, 0x30, 0x94, 0x79, 0xa3, 0xb5,
+	0x5f, 0xd1, 0x29, 0x4b, 0xdd, 0xb2, 0x96, 0xb1, 0x31, 0xb2, 0x07, 0xd5, 0x4e, 0x20, 0xc3, 0x5b,
+	0xe6, 0x56, 0x14, 0xd7, 0x50, 0xde, 0x09, 0x34, 0x8d, 0x5c, 0x2f, 0xbe, 0xe1, 0x84, 0xc0, 0x26,
+	0xca, 0x1b, 0x37, 0xea, 0x37, 0x71, 0xa1, 0xd6, 0xe5, 0xb1, 0x64, 0xb1, 0x54, 0x96, 0x5b, 0x7e,
+	0x46, 0x7a, 0xbf, 0x94, 0x60, 0xa7, 0x13, 0xd3, 0x68, 0x9e, 0x86, 0x69, 0x16, 0xf0, 0x2a, 0x0b,
+	0xbb, 0xb0, 0x75, 0xc5, 0x87, 0x2c, 0x32, 0x91, 0x69, 0x82, 0xfc, 0x0f, 0x9c, 0xee, 0x98, 0x0a,
+	0x1a, 0x48, 0x26, 0xc2, 0x1f, 0xa9, 0x0c, 0x79, 0xac, 0x82, 0xab, 0xfb, 0x77, 0x70, 0xb4, 0x70,
+	0x1d, 0xe2, 0xd9, 0x36, 0xb5, 0x05, 0x45, 0xa0, 0xaf, 0xf3, 0x88, 0x8e, 0xdc, 0x2d, 0xed, 0x0b,
+	0x7f, 0x93, 0x6d, 0x28, 0xf7, 0x86, 0x6e, 0x55, 0x21, 0xe5, 0xde, 0xd0, 0x7b, 0x04, 0x95, 0x4e,
+	0x30, 0xc1, 0xf3, 0xf7, 0x25, 0x95, 0xb3, 0xd4, 0x04, 0x66, 0x28, 0xef, 0x3d, 0xd4, 0x3b, 0xc1,
+	0xa4, 0x3b, 0x66, 0xc1, 0x64, 0x65, 0xe8, 0x0b, 0xbd, 0xb2, 0xad, 0x47, 0x0e, 0xa0, 0x79, 0xca,
+	0xd2, 0x40, 0x84, 0x49, 0x1e, 0x77, 0xc3, 0xb7, 0x21, 0xef, 0x3d, 0x80, 0xc9, 0xec, 0x25, 0xcf,
+	0xc2, 0x42, 0xcb, 0x15, 0x0c, 0x8b, 0xfc, 0x1b, 0x1a, 0x59, 0xde, 0x87, 0xc6, 0xf4, 0x02, 0x40,
+	0xae, 0x3a, 0xa1, 0xa4, 0xd3, 0xc4, 0xd8, 0x5e, 0x00, 0xde, 0xef, 0x25, 0x68, 0x76, 0x79, 0x14,
+	0xb1, 0x40, 0xaa, 0x23, 0xef, 0x43, 0xbd, 0x17, 0x4b, 0x26, 0x6e, 0x69, 0x64, 0x3c, 0xe4, 0x34,
+	0xf2, 0x4e, 0x67, 0x42, 0x27, 0xb7, 0xac, 0x79, 0x19, 0x8d, 0xbc, 0xac, 0x8e, 0x8c, 0x93, 0x9c,
+	0x26, 0xff, 0x01, 0x78, 0x3d, 0x93, 0xc9, 0x4c, 0xbe, 0xa1, 0x72, 0x6c, 0xb2, 0x6e, 0x21, 0x78,
+	0x21, 0x2f, 0x22, 0x1e, 0x4c, 0x4c, 0xee, 0x35, 0x81, 0xa5, 0xf2, 0x8a, 0xc9, 0x1f, 0xb8, 0x98,
+	0x98, 0x1b, 0xc8, 0x48, 0xcc, 0xad, 0xaa, 0xdf, 0x9a, 0xce, 0x2d, 0xfe, 0xf6, 0x2e, 0xa0, 0x75,
+	0x2d, 0x68, 0x18, 0x67, 0xa5, 0x83, 0xb1, 0x52, 0x49, 0x95, 0x47, 0x7d, 0x07, 0x39, 0xbd, 0x14,
+	0x4f, 0x79, 0x39, 0x1e, 0xaf, 0x07, 0xed, 0x53, 0x26, 0x59, 0x90, 0x37, 0x8e, 0x0b, 0xb5, 0x4e,
+	0x92, 0x58, 0xf7, 0x99, 0x91, 0x68, 0x4a, 0x8b, 0xda, 0xa6, 0x16, 0x88, 0xf7, 0x73, 0x09, 0x6d,
+	0xdd, 0x84, 0x31, 0xcb, 0x6c, 0x1d, 0x40, 0xb3, 0xcf, 0xc4, 0x6d, 0x18, 0x30, 0xab, 0x07, 0x6d,
+	0x88, 0x1c, 0xc2, 0x4e, 0x27, 0x49, 0xa2, 0x30, 0x50, 0x99, 0x55, 0x5e, 0xb5, 0xe1, 0x65, 0x18,
+	0x9b, 0xb5, 0x1f, 0xb0, 0x98, 0x8a, 0x90, 0x2b, 0x31, 0x9d, 0xf8, 0x02, 0x66, 0x77, 0xdc, 0x66,
+	0xb1, 0xe3, 0xfa, 0xb0, 0xd3, 0x0f, 0xc6, 0x6c, 0x38, 0x8b, 0xf2, 0xe0, 0x1c, 0xa8, 0x74, 0x92,
+	0xc4, 0x04, 0x85, 0x3f, 0xf3, 0x5c, 0x97, 0x17, 0xb9, 0xc6, 0xdc, 0xf6, 0xa5, 0xa0, 0x92, 0x8d,
+	0xe6, 0xd9, 0x5d, 0x67, 0xb4, 0xf7, 0x47, 0x03, 0xda, 0xd7, 0xb3, 0x38, 0x8c, 0x47, 0x56, 0x13,
+	0xc7, 0x56, 0x27, 0xc4, 0xa6, 0x13, 0x58, 0x3c, 0x0a, 0xe3, 0xcc, 0xae, 0xa1, 0x30, 0xd8, 0xc0,
+	0x04, 0x5b, 0xd1, 0xc1, 0x1a, 0x92, 0x3c, 0x81, 0xad, 0x54, 0x52, 0xc9, 0xd4, 0x21, 0xb6, 0x1f,
+	0x3f, 0x3a, 0xca, 0x46, 0x5e, 0xc1, 0xd9, 0x51, 0xaa, 0x3a, 0xca, 0xd7, 0xb2, 0x98, 0x1f, 0x9f,
+	0xc6, 0x43, 0x3e, 0xed, 0x4b, 0x2a, 0x64, 0xaa, 0xea, 0x6b, 0xcb, 0x2f, 0x60, 0xe4, 0x18, 0xee,
+	0x9f, 0x33, 0x2a, 0x67, 0x82, 0x9d, 0x87, 0x91, 0x64, 0xe2, 0x4c, 0xc7, 0xa5, 0x4b, 0x6e, 0x15,
+	0x8b, 0x1c, 0x01, 0x29, 0xc0, 0xdd, 0x79, 0x10, 0xe9, 0x62, 0xdc, 0xf2, 0x57, 0x70, 0xee, 0xc8,
+	0xf7, 0x24, 0x13, 0xa9, 0x5b, 0x5f, 0x21, 0xaf, 0x38, 0x98, 0x04, 0x1f, 0xbb, 0x53, 0x48, 0xb7,
+	0xa1, 0x46, 0x58, 0x46, 0x92, 0xff, 0x42, 0xbb, 0x20, 0xef, 0x82, 0xe2, 0x17, 0x41, 0xf2, 0x19,
+	0x34, 0x74, 0x52, 0x2e, 0xf9, 0xc8, 0x6d, 0x1e, 0x94, 0x0e, 0x9b, 0x8f, 0xf7, 0x96, 0xd2, 0xf5,
+	0x6d, 0x98, 0x4a, 0x2e, 0xe6, 0xfe, 0x42, 0x10, 0x2b, 0xb9, 0x9f, 0x44, 0xa1, 0xec, 0xf2, 0x59,
+	0x2c, 0xdd, 0x96, 0x8a, 0xce, 0x42, 0xee, 0x9e, 0x5a, 0xc9, 0xb5, 0x57, 0x9d, 0x5a, 0xc9, 0x1f,
+	0xc2, 0xce, 0xd9, 0x2d, 0x8d, 0xce, 0xa3, 0x59, 0x20, 0x67, 0x7a, 0x66, 0x6c, 0x1f, 0x94, 0x0e,
+	0x4b, 0xfe, 0x32, 0x8c, 0x92, 0x46, 0xbf, 0xcf, 0x70, 0x0e, 0x71, 0xe1, 0xee, 0xe8, 0x7a, 0x5f,
+	0x82, 0xf1, 0xfc, 0xbd, 0x38, 0x94, 0x21, 0x8d, 0xba, 0x3c, 0xbe, 0x09, 0x47, 0xae, 0xa3, 0xe4,
+	0x8a, 0xa0, 0x19, 0x8f, 0xf7, 0xb2, 0xa9, 0x8d, 0x1d, 0x77, 0x45, 0x3f, 0xe4, 0x93, 0x8b, 0xa8,
+	0xc9, 0x65, 0x43, 0xe4, 0xff, 0x70, 0xef, 0x9a, 0x8a, 0x11, 0x93, 0xbd, 0x69, 0x22, 0xf8, 0x2d,
+	0x9b, 0x62, 0x01, 0xde, 0x57, 0xd1, 0xde, 0x65, 0xa8, 0x27, 0x32, 0xa2, 0x92, 0xd1, 0x99, 0xbe,
+	0xc9, 0x5d, 0x5d, 0x55, 0x36, 0x86, 0xd9, 0xca, 0x68, 0xcb, 0xe4, 0x03, 0x65, 0x72, 0x05, 0x07,
+	0x4f, 0xf6, 0x7a, 0xf0, 0x3d, 0x53, 0xef, 0x28, 0xbe, 0x68, 0xee, 0x9e, 0x3e, 0x59, 0x01, 0x54,
+	0x83, 0x2b, 0x03, 0x52, 0xf7, 0x5f, 0x07, 0x15, 0x35, 0xb8, 0x72, 0x04, 0x47, 0xfd, 0x3b, 0x2a,
+	0x74, 0x65, 0xbb, 0xae, 0x1e, 0xf5, 0x39, 0x80, 0x3e, 0x72, 0x02, 0x67, 0xa1, 0xfb, 0x50, 0xb5,
+	0x58, 0x11, 0xc4, 0xdb, 0xc8, 0x81, 0x77, 0x2c, 0x1c, 0x8d, 0xa5, 0xbb, 0xaf, 0xef, 0x6d, 0x09,
+	0xf6, 0xfe, 0x2c, 0x41, 0x55, 0xf7, 0x1b, 0x69, 0x42, 0xed, 0x82, 0x0f, 0xf0, 0x1a, 0x9c, 0x0d,
+	0xb2, 0x0d, 0x70, 0xc1, 0x07, 0xa6, 0x66, 0x9d, 0x12, 0x69, 0x43, 0xe3, 0x05, 0x8b, 0x83, 0xf1,
+	0x15, 0x15, 0x13, 0xa7, 0x8c, 0xb2, 0xc8, 0xe3, 0x82, 0x39, 0x15, 0x02, 0x50, 0x3d, 0x8b, 0x87,
+	0x61, 0x3c, 0x72, 0x36, 0x91, 0x71, 0x1a, 0xa6, 0x49, 0x44, 0xe7, 0xce, 0x16, 0x1a, 0xe9, 0xcf,
+	0xe3, 0x40, 0x5f, 0xa9, 0x53, 0x45, 0xc1, 0x53, 0x26, 0x69, 0x18, 0x39, 0x35, 0x34, 0x78, 0x3d,
+	0x16, 0x2c, 0x1d, 0xf3, 0x68, 0xe8, 0xd4, 0x91, 0xbc, 0xe0, 0x83, 0xae, 0x60, 0x54, 0x32, 0xa7,
+	0x41, 0x76, 0xc1, 0x79, 0xc9, 0x64, 0xa1, 0x24, 0x1c, 0x20, 0x0d, 0xd8, 0xc2, 0xe9, 0x39, 0x77,
+	0x9a, 0xca, 0xe7, 0x87, 0x84, 0x0b, 0xe9, 0xb4, 0xc8, 0x0e, 0x34, 0x15, 0x7c, 0x4e, 0xc3, 0x88,
+	0x0d, 0x9d, 0xb6, 0xf2, 0x23, 0xe6, 0xfe, 0x2c, 0x76, 0xb6, 0xbd, 0x5f, 0x4b, 0xd0, 0x2e, 0xf4,
+	0x0b, 0x4e, 0xbe, 0x17, 0x34, 0x65, 0x67, 0xd9, 0xeb, 0xd8, 0xf0, 0x73, 0x1a, 0xdb, 0xf6, 0x2a,
+	0x8c, 0x15, 0x4b, 0x0f, 0xb5, 0x8c, 0x44, 0x4e, 0x7f, 0x36, 0x55, 0x1c, 0x3d, 0x2e, 0x33, 0x52,
+	0xbd, 0xcd, 0x5c, 0xd2, 0x08, 0xdf, 0x63, 0x35, 0xd9, 0x2a, 0xfe, 0x02, 0x30, 0xfb, 0xc2, 0x62,
+	0x70, 0x19, 0xca, 0xda, 0x23, 0xaa, 0x85, 0xfd, 0xe3, 0xa7, 0xb2, 0xb9, 0x86, 0x1b, 0x6e, 0xed,
+	0x08, 0xba, 0x09, 0x56, 0xcd, 0x71, 0x17, 0x6a, 0x6f, 0x04, 0xc7, 0xea, 0xc9, 0xe2, 0x32, 0xa4,
+	0xe5, 0x61, 0xd3, 0xf6, 0x80, 0xf1, 0xaa, 0x18, 0x54, 0xbc, 0xfa, 0xb5, 0x5e, 0x00, 0xc8, 0xc5,
+	0xea, 0xd7, 0x6d, 0x56, 0x55, 0x21, 0x2f, 0x00, 0x6c, 0x9b, 0x2b, 0xfa, 0x61, 0x21, 0xa0, 0x07,
+	0x66, 0x01, 0xc3, 0x4d, 0xe0, 0xbb, 0x98, 0x0f, 0xf4, 0x74, 0x6c, 0xf8, 0x9a, 0x50, 0x59, 0x67,
+	0xa9, 0x54, 0x09, 0x6c, 0x98, 0xac, 0x1b, 0x1a, 0x9b, 0xfb, 0x2c, 0xa2, 0x49, 0xca, 0x86, 0x2a,
+	0x26, 0xd0, 0xcd, 0x6d, 0x41, 0xde, 0xa5, 0x2a, 0x47, 0x7c, 0xf4, 0x04, 0x8f, 0xee, 0xe4, 0xc5,
+	0xec, 0xb2, 0x66, 0xa3, 0x31, 0xbb, 0x2c, 0x8f, 0x11, 0x7f, 0x6d, 0xaf, 0x63, 0x86, 0xf2, 0x4e,
+	0xa0, 0xed, 0x33, 0x2c, 0x9e, 0x8f, 0xed, 0xa8, 0x7b, 0x50, 0x3d, 0xe7, 0x62, 0x4a, 0x65, 0x66,
+	0x54, 0x53, 0xde, 0x29, 0x38, 0xaa, 0x13, 0xa6, 0x54, 0x4c, 0x32, 0x7d, 0x4c, 0xb5, 0xda, 0xf3,
+	0xf2, 0x65, 0x52, 0x51, 0x78, 0x39, 0x18, 0x3e, 0x9f, 0x49, 0xb3, 0x6b, 0x65, 0x24, 0xbe, 0xdb,
+	0xb9, 0x15, 0xbd, 0xb5, 0xa8, 0x68, 0xd5, 0x2f, 0x65, 0xa4, 0xe5, 0x1b, 0x0a, 0x83, 0x3b, 0xe5,
+	0xe6, 0x95, 0xad, 0xfb, 0xea, 0x37, 0xe6, 0xf8, 0x4c, 0x08, 0x2e, 0xcc, 0xc1, 0x34, 0xf1, 0xf8,
+	0x37, 0xc8, 0x57, 0xcc, 0xab, 0x91, 0x20, 0x4f, 0xa1, 0x66, 0x28, 0xb2, 0x9b, 0xbf, 0x1d, 0xd6,
+	0x72, 0xbf, 0x7f, 0x2f, 0x47, 0xb3, 0x95, 0xd7, 0xdb, 0x38, 0x2e, 0x91, 0x6f, 0x70, 0x0f, 0x67,
+	0xc1, 0x04, 0xdb, 0xef, 0x93, 0x0c, 0x9c, 0x40, 0x3d, 0xfb, 0x0a, 0x20, 0xee, 0x42, 0xa4, 0xf8,
+	0x61, 0xb0, 0x4e, 0xf9, 0x39, 0x54, 0x75, 0xbf, 0x92, 0xbd, 0xd5, 0xfb, 0xc1, 0xfe, 0x1a, 0xdc,
+	0xdb, 0x38, 0x2c, 0x29, 0xfd, 0x16, 0x7e, 0x2f, 0xe5, 0x8b, 0xeb, 0xea, 0xc8, 0x17, 0xa8, 0xf5,
+	0x71, 0xa5, 0xfc, 0x3f, 0x83, 0xed, 0xb7, 0xc9, 0x48, 0xd0, 0x21, 0xfb, 0xa4, 0xb3, 0x3f, 0x83,
+	0x26, 0xb2, 0x3f, 0xae, 0xbb, 0x12, 0x55, 0xea, 0x1d, 0x20, 0xca, 0x96, 0xfe, 0x1a, 0xfb, 0xa4,
+	0x08, 0x9e, 0xc3, 0x8e, 0x91, 0xf2, 0x79, 0x14, 0x0d, 0x68, 0x30, 0xf9, 0x67, 0xfa, 0x5f, 0x01,
+	0x98, 0x8f, 0x09, 0xd5, 0xcd, 0xb9, 0x90, 0xf5, 0x85, 0xb1, 0x4e, 0xf5, 0x4b, 0xa8, 0xab, 0x05,
+	0x1e, 0x6f, 0xef, 0xc1, 0xe2, 0x96, 0xac, 0x9d, 0x7e, 0x9d, 0xe6, 0x31, 0x54, 0xf5, 0x8a, 0x6d,
+	0xdd, 0x7a, 0x61, 0xe7, 0xde, 0x6f, 0xd9, 0x8a, 0xde, 0x06, 0x39, 0x42, 0x8d, 0x88, 0xc9, 0x75,
+	0xd9, 0x59, 0x21, 0xff, 0x36, 0x19, 0xd2, 0xbf, 0x2d, 0x7f, 0x02, 0xf5, 0x6c, 0xb3, 0xb6, 0x8a,
+	0x78, 0x69, 0xd9, 0x5e, 0x77, 0x9c, 0x2f, 0xa0, 0xfe, 0x92, 0xc5, 0x4c, 0xac, 0x77, 0xb7, 0x46,
+	0xf1, 0x6b, 0x68, 0xe8, 0x2f, 0x8f, 0x62, 0x03, 0x14, 0x3e, 0x65, 0xd6, 0xe9, 0x3e, 0x85, 0x3a,
+	0x16, 0xf3, 0x05, 0x8e, 0xdb, 0xd5, 0x4e, 0x9d, 0x1c, 0x35, 0x4f, 0x8c, 0x29, 0xd9, 0x46, 0x47,
+	0x4a, 0x1a, 0x8c, 0x2f, 0xf8, 0x60, 0x8d, 0xe2, 0xda, 0x96, 0x3b, 0x2e, 0x91, 0xcf, 0x01, 0xcc,
+	0x60, 0x46, 0xfd, 0xfb, 0xb6, 0x0b, 0x83, 0xaf, 0xf2, 0x8b, 0x7d, 0xaa, 0x6d, 0xe9, 0x51, 0x6c,
+	0x1d, 0xb6, 0x30, 0x9b, 0xd7, 0xf5, 0x0a, 0x79, 0x09, 0x2d, 0x7f, 0x16, 0xe7, 0x43, 0x94, 0x3c,
+	0xcc, 0xe5, 0x96, 0xc7, 0xf3, 0xbe, 0x7b, 0x97, 0xa5, 0x67, 0x2b, 0xc6, 0x3f, 0xa8, 0xaa, 0x3f,
+	0x5c, 0x9e, 0xfc, 0x35, 0x00, 0x03, 0x9f, 0x7f, 0x62, 0x81, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1052,6 +1508,11 @@ type ProfileMgrClient interface {
 	Schedule(ctx context.Context, in *ScheduleMessage, opts ...grpc.CallOption) (ProfileMgr_ScheduleClient, error)
 	Generate(ctx context.Context, in *ProfileInfo, opts ...grpc.CallOption) (ProfileMgr_GenerateClient, error)
 	Detecting(ctx context.Context, in *DetectMessage, opts ...grpc.CallOption) (ProfileMgr_DetectingClient, error)
+	ListJobs(ctx context.Context, in *ProfileInfo, opts ...grpc.CallOption) (ProfileMgr_ListJobsClient, error)
+	AttachJob(ctx context.Context, in *ProfileInfo, opts ...grpc.CallOption) (ProfileMgr_AttachJobClient, error)
+	ControlJob(ctx context.Context, in *JobControl, opts ...grpc.CallOption) (*JobInfo, error)
+	TuningReport(ctx context.Context, in *ReportMessage, opts ...grpc.CallOption) (*ProfileInfo, error)
+	RunBenchmark(ctx context.Context, in *BenchmarkMessage, opts ...grpc.CallOption) (ProfileMgr_RunBenchmarkClient, error)
 }
 
 type profileMgrClient struct {
@@ -1536,6 +1997,120 @@ func (x *profileMgrDetectingClient) Recv() (*AckCheck, error) {
 	return m, nil
 }
 
+func (c *profileMgrClient) ListJobs(ctx context.Context, in *ProfileInfo, opts ...grpc.CallOption) (ProfileMgr_ListJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProfileMgr_serviceDesc.Streams[14], "/profile.ProfileMgr/ListJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileMgrListJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProfileMgr_ListJobsClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
+}
+
+type profileMgrListJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileMgrListJobsClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *profileMgrClient) AttachJob(ctx context.Context, in *ProfileInfo, opts ...grpc.CallOption) (ProfileMgr_AttachJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProfileMgr_serviceDesc.Streams[15], "/profile.ProfileMgr/AttachJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileMgrAttachJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProfileMgr_AttachJobClient interface {
+	Recv() (*TuningMessage, error)
+	grpc.ClientStream
+}
+
+type profileMgrAttachJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileMgrAttachJobClient) Recv() (*TuningMessage, error) {
+	m := new(TuningMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *profileMgrClient) ControlJob(ctx context.Context, in *JobControl, opts ...grpc.CallOption) (*JobInfo, error) {
+	out := new(JobInfo)
+	err := c.cc.Invoke(ctx, "/profile.ProfileMgr/ControlJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileMgrClient) TuningReport(ctx context.Context, in *ReportMessage, opts ...grpc.CallOption) (*ProfileInfo, error) {
+	out := new(ProfileInfo)
+	err := c.cc.Invoke(ctx, "/profile.ProfileMgr/TuningReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileMgrClient) RunBenchmark(ctx context.Context, in *BenchmarkMessage, opts ...grpc.CallOption) (ProfileMgr_RunBenchmarkClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProfileMgr_serviceDesc.Streams[16], "/profile.ProfileMgr/RunBenchmark", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileMgrRunBenchmarkClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProfileMgr_RunBenchmarkClient interface {
+	Recv() (*BenchmarkOutput, error)
+	grpc.ClientStream
+}
+
+type profileMgrRunBenchmarkClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileMgrRunBenchmarkClient) Recv() (*BenchmarkOutput, error) {
+	m := new(BenchmarkOutput)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ProfileMgrServer is the server API for ProfileMgr service.
 type ProfileMgrServer interface {
 	Profile(*ProfileInfo, ProfileMgr_ProfileServer) error
@@ -1555,6 +2130,11 @@ type ProfileMgrServer interface {
 	Schedule(*ScheduleMessage, ProfileMgr_ScheduleServer) error
 	Generate(*ProfileInfo, ProfileMgr_GenerateServer) error
 	Detecting(*DetectMessage, ProfileMgr_DetectingServer) error
+	ListJobs(*ProfileInfo, ProfileMgr_ListJobsServer) error
+	AttachJob(*ProfileInfo, ProfileMgr_AttachJobServer) error
+	ControlJob(context.Context, *JobControl) (*JobInfo, error)
+	TuningReport(context.Context, *ReportMessage) (*ProfileInfo, error)
+	RunBenchmark(*BenchmarkMessage, ProfileMgr_RunBenchmarkServer) error
 }
 
 func RegisterProfileMgrServer(s *grpc.Server, srv ProfileMgrServer) {
@@ -1797,27 +2377,6 @@ func (x *profileMgrTrainingServer) Send(m *AckCheck) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ProfileMgr_Detecting_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DetectMessage)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ProfileMgrServer).Detecting(m, &profileMgrDetectingServer{stream})
-}
-
-type ProfileMgr_DetectingServer interface {
-	Send(*AckCheck) error
-	grpc.ServerStream
-}
-
-type profileMgrDetectingServer struct {
-	grpc.ServerStream
-}
-
-func (x *profileMgrDetectingServer) Send(m *AckCheck) error {
-	return x.ServerStream.SendMsg(m)
-}
-
 func _ProfileMgr_Define_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DefineMessage)
 	if err := dec(in); err != nil {
@@ -1914,6 +2473,126 @@ func (x *profileMgrGenerateServer) Send(m *AckCheck) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ProfileMgr_Detecting_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DetectMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileMgrServer).Detecting(m, &profileMgrDetectingServer{stream})
+}
+
+type ProfileMgr_DetectingServer interface {
+	Send(*AckCheck) error
+	grpc.ServerStream
+}
+
+type profileMgrDetectingServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileMgrDetectingServer) Send(m *AckCheck) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProfileMgr_ListJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileMgrServer).ListJobs(m, &profileMgrListJobsServer{stream})
+}
+
+type ProfileMgr_ListJobsServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
+}
+
+type profileMgrListJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileMgrListJobsServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProfileMgr_AttachJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileInfo)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileMgrServer).AttachJob(m, &profileMgrAttachJobServer{stream})
+}
+
+type ProfileMgr_AttachJobServer interface {
+	Send(*TuningMessage) error
+	grpc.ServerStream
+}
+
+type profileMgrAttachJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileMgrAttachJobServer) Send(m *TuningMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProfileMgr_ControlJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobControl)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileMgrServer).ControlJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileMgr/ControlJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileMgrServer).ControlJob(ctx, req.(*JobControl))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileMgr_TuningReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileMgrServer).TuningReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileMgr/TuningReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileMgrServer).TuningReport(ctx, req.(*ReportMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileMgr_RunBenchmark_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BenchmarkMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileMgrServer).RunBenchmark(m, &profileMgrRunBenchmarkServer{stream})
+}
+
+type ProfileMgr_RunBenchmarkServer interface {
+	Send(*BenchmarkOutput) error
+	grpc.ServerStream
+}
+
+type profileMgrRunBenchmarkServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileMgrRunBenchmarkServer) Send(m *BenchmarkOutput) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _ProfileMgr_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "profile.ProfileMgr",
 	HandlerType: (*ProfileMgrServer)(nil),
@@ -1930,6 +2609,14 @@ var _ProfileMgr_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _ProfileMgr_Update_Handler,
 		},
+		{
+			MethodName: "ControlJob",
+			Handler:    _ProfileMgr_ControlJob_Handler,
+		},
+		{
+			MethodName: "TuningReport",
+			Handler:    _ProfileMgr_TuningReport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2003,6 +2690,21 @@ var _ProfileMgr_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ProfileMgr_Detecting_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ListJobs",
+			Handler:       _ProfileMgr_ListJobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AttachJob",
+			Handler:       _ProfileMgr_AttachJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RunBenchmark",
+			Handler:       _ProfileMgr_RunBenchmark_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "profile.proto",
 }
@@ -154,6 +154,9 @@ func doBeforeJob(ctx *cli.Context) error {
 func runatuned(ctx *cli.Context) error {
 	var lis net.Listener
 	var err error
+	if config.Agent && !config.GrpcTLS {
+		return fmt.Errorf("grpc_tls must be enabled to run atuned in agent mode")
+	}
 	if config.TransProtocol == "tcp" {
 		lis, err = net.Listen("tcp", config.Address+":"+config.Port)
 	} else if config.TransProtocol == "unix" {
@@ -217,6 +220,13 @@ func runatuned(ctx *cli.Context) error {
 	}
 
 	log.Info("pyservice has been started")
+	for name, inst := range server.svcs {
+		if svc, ok := inst.(SVC.ReadyService); ok {
+			if err := svc.Ready(); err != nil {
+				log.Errorf("service %s is not ready: %v", name, err)
+			}
+		}
+	}
 	_, _ = daemon.SdNotify(false, "READY=1")
 
 	reflection.Register(s)
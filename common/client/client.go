@@ -32,11 +32,47 @@ import (
 
 type clientOpts struct {
 	dialOptions []grpc.DialOption
+	creds       credentials.TransportCredentials
 }
 
 // Opt allows callers to set options on the client
 type Opt func(c *clientOpts) error
 
+// WithTLS method return the option to connect the server with the certificates
+// instead of the certificates in the environment variables
+func WithTLS(caFile string, certFile string, keyFile string, serverName string) Opt {
+	return func(c *clientOpts) error {
+		creds, err := newTLSCreds(caFile, certFile, keyFile, serverName)
+		if err != nil {
+			return err
+		}
+		c.creds = creds
+		return nil
+	}
+}
+
+func newTLSCreds(caFile string, certFile string, keyFile string, serverName string) (credentials.TransportCredentials, error) {
+	pool := x509.NewCertPool()
+	caCrt, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	if ok := pool.AppendCertsFromPEM(caCrt); !ok {
+		return nil, fmt.Errorf("failed to append ca certs in client")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ServerName:   serverName,
+		RootCAs:      pool,
+	}), nil
+}
+
 //Client :The grpc client structer
 type Client struct {
 	conn     *grpc.ClientConn
@@ -159,28 +195,15 @@ func newClient(address string, opts ...Opt) (*Client, error) {
 	}
 
 	envTls := os.Getenv(config.EnvTLS)
-	if envTls == "yes" {
-		pool := x509.NewCertPool()
-		caCrt, err := ioutil.ReadFile(os.Getenv(config.EnvCaCert))
-		if err != nil {
-			return nil, err
-		}
-		if ok := pool.AppendCertsFromPEM(caCrt); !ok {
-			return nil, fmt.Errorf("failed to append ca certs in client")
-		}
-
-		certificate, err := tls.LoadX509KeyPair(os.Getenv(config.EnvClientCert),
-			os.Getenv(config.EnvClientKey))
+	if copts.creds != nil {
+		gopts = append(gopts, grpc.WithTransportCredentials(copts.creds))
+	} else if envTls == "yes" {
+		creds, err := newTLSCreds(os.Getenv(config.EnvCaCert), os.Getenv(config.EnvClientCert),
+			os.Getenv(config.EnvClientKey), os.Getenv(config.EnvServerCN))
 		if err != nil {
 			return nil, err
 		}
 
-		creds := credentials.NewTLS(&tls.Config{
-			Certificates: []tls.Certificate{certificate},
-			ServerName:   os.Getenv(config.EnvServerCN),
-			RootCAs:      pool,
-		})
-
 		gopts = append(gopts, grpc.WithTransportCredentials(creds))
 	} else {
 		gopts = append(gopts, grpc.WithInsecure())
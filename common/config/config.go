@@ -96,13 +96,24 @@ const (
 	TuningFile          string  = "tuning.log"
 	TuningRuleFile      string  = "tuning_rules.grl"
 	TuningRestoreConfig string  = "-tuning-restore.conf"
+	TuningRebootState   string  = "-tuning-reboot.json"
 	DefaultTimeFormat   string  = "2006-01-02 15:04:05.000"
 	Percent             float64 = 0.6
+	FeatureFluctuation  float64 = 0.001
 )
 
 // client yaml config
 var (
 	EvaluationType = []string{"negative", "positive"}
+	AggregateType  = []string{"mean", "median", "trimmed_mean", "min", "max"}
+	FailureType    = []string{"abort", "penalize", "skip"}
+	ObjectiveModes = []string{"weighted", "pareto"}
+	EvaluationRole = []string{"objective", "constraint"}
+)
+
+// the action when the tuning client is disconnected
+var (
+	DisconnectPolicies = []string{"restore", "best", "keep"}
 )
 
 // the grpc server config
@@ -110,12 +121,16 @@ var (
 	TransProtocol           string
 	Address                 string
 	Connect                 string
+	Inventory               string
+	NodeAllowlist           []string
+	BenchmarkAllowlist      []string
 	Port                    string
 	LocalHost               string
 	RestPort                string
 	EngineHost              string
 	EnginePort              string
 	GrpcTLS                 bool
+	Agent                   bool
 	RestTLS                 bool
 	EngineTLS               bool
 	TLSServerCaFile         string
@@ -133,8 +148,11 @@ var (
 
 // the tuning configs
 var (
-	Noise      float64
-	SelFeature bool
+	Noise            float64
+	SelFeature       bool
+	DisconnectPolicy string
+	RebootCommand    string
+	VerifyTolerance  float64
 )
 
 // the system config in atuned.cnf
@@ -173,6 +191,17 @@ func (c *Cfg) Load() error {
 	if section.HasKey("connect") {
 		Connect = section.Key("connect").MustString("")
 	}
+	Inventory = section.Key("inventory").MustString("")
+	for _, name := range strings.Split(section.Key("node_allowlist").MustString(""), ",") {
+		if strings.TrimSpace(name) != "" {
+			NodeAllowlist = append(NodeAllowlist, strings.TrimSpace(name))
+		}
+	}
+	for _, name := range strings.Split(section.Key("benchmark_allowlist").MustString(""), ",") {
+		if strings.TrimSpace(name) != "" {
+			BenchmarkAllowlist = append(BenchmarkAllowlist, strings.TrimSpace(name))
+		}
+	}
 
 	if section.HasKey("port") {
 		Port = section.Key("port").MustString(DefaultTgtPort)
@@ -183,6 +212,7 @@ func (c *Cfg) Load() error {
 	EnginePort = section.Key("engine_port").MustString("3838")
 	utils.RestHost = LocalHost
 	utils.RestPort = RestPort
+	Agent = section.Key("agent").MustBool(false)
 
 	if section.HasKey("grpc_tls") {
 		GrpcTLS = section.Key("grpc_tls").MustBool(false)
@@ -236,6 +266,9 @@ func (c *Cfg) Load() error {
 	section = cfg.Section("tuning")
 	Noise = section.Key("noise").MustFloat64(0.000000001)
 	SelFeature = section.Key("sel_feature").MustBool(false)
+	DisconnectPolicy = section.Key("disconnect_policy").In(DisconnectPolicies[0], DisconnectPolicies)
+	RebootCommand = section.Key("reboot_command").MustString("systemctl reboot")
+	VerifyTolerance = section.Key("verify_tolerance").MustFloat64(0.01)
 
 	if err := initLogging(cfg); err != nil {
 		return err
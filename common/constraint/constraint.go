@@ -0,0 +1,506 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package constraint
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Constraint : the compiled constraint expression between the tuning knobs,
+// such as "innodb_buffer_pool_size + key_buffer_size <= 0.8 * mem_total"
+type Constraint struct {
+	Expr string
+	root node
+	vars []string
+}
+
+// Parse method compile the constraint expression
+func Parse(expr string) (*Constraint, error) {
+	p := &parser{input: expr}
+	if err := p.tokenize(); err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %v", expr, err)
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid constraint %q: %v", expr, err)
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("invalid constraint %q: unexpected %s", expr, p.tokens[p.pos].text)
+	}
+	return &Constraint{Expr: expr, root: root, vars: p.vars}, nil
+}
+
+// Vars method return the names of the variables used in the expression
+func (c *Constraint) Vars() []string {
+	return c.vars
+}
+
+// Check method evaluate the expression with the knob values and the host
+// facts, the knob value which is not a number is compared as a string
+func (c *Constraint) Check(values map[string]string, facts map[string]float64) (bool, error) {
+	env := func(name string) (interface{}, error) {
+		if value, ok := values[name]; ok {
+			value = strings.TrimSpace(value)
+			if number, err := strconv.ParseFloat(value, 64); err == nil {
+				return number, nil
+			}
+			return value, nil
+		}
+		if value, ok := facts[name]; ok {
+			return value, nil
+		}
+		return nil, fmt.Errorf("unknown variable %s", name)
+	}
+
+	result, err := c.root.eval(env)
+	if err != nil {
+		return false, fmt.Errorf("constraint %q: %v", c.Expr, err)
+	}
+	matched, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("constraint %q is not a comparison", c.Expr)
+	}
+	return matched, nil
+}
+
+type lookup func(name string) (interface{}, error)
+
+type node interface {
+	eval(env lookup) (interface{}, error)
+}
+
+type numberNode float64
+
+func (n numberNode) eval(env lookup) (interface{}, error) {
+	return float64(n), nil
+}
+
+type stringNode string
+
+func (n stringNode) eval(env lookup) (interface{}, error) {
+	return string(n), nil
+}
+
+type varNode string
+
+func (n varNode) eval(env lookup) (interface{}, error) {
+	return env(string(n))
+}
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n *unaryNode) eval(env lookup) (interface{}, error) {
+	value, err := n.operand.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operand of ! is not a comparison")
+		}
+		return !b, nil
+	}
+	number, err := toNumber(value, n.op)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "-" {
+		return -number, nil
+	}
+	return number, nil
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n *binaryNode) eval(env lookup) (interface{}, error) {
+	left, err := n.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.op == "&&" || n.op == "||" {
+		l, ok := left.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operand of %s is not a comparison", n.op)
+		}
+		if n.op == "&&" && !l || n.op == "||" && l {
+			return l, nil
+		}
+		right, err := n.right.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		r, ok := right.(bool)
+		if !ok {
+			return nil, fmt.Errorf("operand of %s is not a comparison", n.op)
+		}
+		return r, nil
+	}
+
+	right, err := n.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	ls, lok := left.(string)
+	rs, rok := right.(string)
+	if lok || rok {
+		if !lok || !rok {
+			return nil, fmt.Errorf("can not compare %v with %v", left, right)
+		}
+		switch n.op {
+		case "==":
+			return ls == rs, nil
+		case "!=":
+			return ls != rs, nil
+		}
+		return nil, fmt.Errorf("operator %s is not supported for strings", n.op)
+	}
+
+	l, err := toNumber(left, n.op)
+	if err != nil {
+		return nil, err
+	}
+	r, err := toNumber(right, n.op)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return math.Mod(l, r), nil
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	case ">=":
+		return l >= r, nil
+	case "==":
+		return l == r, nil
+	case "!=":
+		return l != r, nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.op)
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+func (n *callNode) eval(env lookup) (interface{}, error) {
+	args := make([]float64, 0, len(n.args))
+	for _, arg := range n.args {
+		value, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		number, err := toNumber(value, n.name)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, number)
+	}
+
+	switch n.name {
+	case "abs":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("abs needs 1 argument")
+		}
+		return math.Abs(args[0]), nil
+	case "min", "max":
+		if len(args) == 0 {
+			return nil, fmt.Errorf("%s needs at least 1 argument", n.name)
+		}
+		result := args[0]
+		for _, arg := range args[1:] {
+			if n.name == "min" {
+				result = math.Min(result, arg)
+			} else {
+				result = math.Max(result, arg)
+			}
+		}
+		return result, nil
+	}
+	return nil, fmt.Errorf("unknown function %s", n.name)
+}
+
+func toNumber(value interface{}, op string) (float64, error) {
+	number, ok := value.(float64)
+	if !ok {
+		return 0, fmt.Errorf("operand %v of %s is not a number", value, op)
+	}
+	return number, nil
+}
+
+type token struct {
+	kind string
+	text string
+}
+
+const (
+	tokenNumber = "number"
+	tokenString = "string"
+	tokenIdent  = "ident"
+	tokenOp     = "op"
+)
+
+type parser struct {
+	input  string
+	tokens []token
+	pos    int
+	vars   []string
+}
+
+func isIdent(r rune, first bool) bool {
+	if unicode.IsLetter(r) || r == '_' {
+		return true
+	}
+	return !first && (unicode.IsDigit(r) || r == '.')
+}
+
+func (p *parser) tokenize() error {
+	runes := []rune(p.input)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' ||
+				runes[i] == 'e' || runes[i] == 'E' ||
+				(runes[i] == '-' || runes[i] == '+') && (runes[i-1] == 'e' || runes[i-1] == 'E')) {
+				i++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenNumber, text: string(runes[start:i])})
+		case isIdent(r, true):
+			start := i
+			for i < len(runes) && isIdent(runes[i], false) {
+				i++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenIdent, text: string(runes[start:i])})
+		case r == '"' || r == '\'':
+			start := i + 1
+			i++
+			for i < len(runes) && runes[i] != r {
+				i++
+			}
+			if i >= len(runes) {
+				return fmt.Errorf("unterminated string")
+			}
+			p.tokens = append(p.tokens, token{kind: tokenString, text: string(runes[start:i])})
+			i++
+		default:
+			op := string(r)
+			if i+1 < len(runes) {
+				switch two := string(runes[i : i+2]); two {
+				case "<=", ">=", "==", "!=", "&&", "||":
+					op = two
+				}
+			}
+			if len(op) == 1 && !strings.Contains("+-*/%()<>!,", op) {
+				return fmt.Errorf("unexpected character %s", op)
+			}
+			p.tokens = append(p.tokens, token{kind: tokenOp, text: op})
+			i += len(op)
+		}
+	}
+	return nil
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) || p.tokens[p.pos].kind != tokenOp {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+func (p *parser) expect(op string) error {
+	if p.peek() != op {
+		return fmt.Errorf("expect %s", op)
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) parseBinary(next func() (node, error), ops ...string) (node, error) {
+	left, err := next()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := p.peek()
+		matched := false
+		for _, candidate := range ops {
+			if op == candidate {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return left, nil
+		}
+		p.pos++
+		right, err := next()
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) parseOr() (node, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *parser) parseAnd() (node, error) {
+	return p.parseBinary(p.parseNot, "&&")
+}
+
+func (p *parser) parseNot() (node, error) {
+	if p.peek() == "!" {
+		p.pos++
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: "!", operand: operand}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (node, error) {
+	left, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	switch op := p.peek(); op {
+	case "<", "<=", ">", ">=", "==", "!=":
+		p.pos++
+		right, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		return &binaryNode{op: op, left: left, right: right}, nil
+	}
+	return left, nil
+}
+
+func (p *parser) parseSum() (node, error) {
+	return p.parseBinary(p.parseTerm, "+", "-")
+}
+
+func (p *parser) parseTerm() (node, error) {
+	return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if op := p.peek(); op == "-" || op == "+" {
+		p.pos++
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{op: op, operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	if p.pos >= len(p.tokens) {
+		return nil, fmt.Errorf("unexpected end")
+	}
+	tok := p.tokens[p.pos]
+	p.pos++
+	switch tok.kind {
+	case tokenNumber:
+		number, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", tok.text)
+		}
+		return numberNode(number), nil
+	case tokenString:
+		return stringNode(tok.text), nil
+	case tokenIdent:
+		if p.peek() != "(" {
+			p.addVar(tok.text)
+			return varNode(tok.text), nil
+		}
+		p.pos++
+		call := &callNode{name: tok.text}
+		for p.peek() != ")" {
+			arg, err := p.parseSum()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, arg)
+			if p.peek() != "," {
+				break
+			}
+			p.pos++
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return call, nil
+	}
+
+	if tok.text == "(" {
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	}
+	return nil, fmt.Errorf("unexpected %s", tok.text)
+}
+
+func (p *parser) addVar(name string) {
+	for _, v := range p.vars {
+		if v == name {
+			return
+		}
+	}
+	p.vars = append(p.vars, name)
+}
@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package constraint
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr string
+		vars []string
+		fail bool
+	}{
+		{"innodb_buffer_pool_size + key_buffer_size <= 0.8 * mem_total",
+			[]string{"innodb_buffer_pool_size", "key_buffer_size", "mem_total"}, false},
+		{"a > 1 && (b < 2 || !(c == 'on'))", []string{"a", "b", "c"}, false},
+		{"max(a, b, 1e3) - abs(-c) >= 2.5E-1", []string{"a", "b", "c"}, false},
+		{"net.core.somaxconn % 2 == 0", []string{"net.core.somaxconn"}, false},
+		{"a + a < 4", []string{"a"}, false},
+		{"a >", nil, true},
+		{"(a > 1", nil, true},
+		{"a > 1 b", nil, true},
+		{"a == 'on", nil, true},
+		{"a # 1", nil, true},
+		{"max(a, b", nil, true},
+		{"", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := Parse(tt.expr)
+			if tt.fail {
+				if err == nil {
+					t.Errorf("Parse succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if strings.Join(c.Vars(), ",") != strings.Join(tt.vars, ",") {
+				t.Errorf("Vars = %v, want %v", c.Vars(), tt.vars)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	facts := map[string]float64{"mem_total": 1000, "cpu_count": 8}
+	tests := []struct {
+		expr   string
+		values map[string]string
+		want   bool
+		fail   bool
+	}{
+		{"a + b <= 0.8 * mem_total", map[string]string{"a": "500", "b": "300"}, true, false},
+		{"a + b <= 0.8 * mem_total", map[string]string{"a": "500", "b": "301"}, false, false},
+		{"threads <= cpu_count * 2", map[string]string{"threads": " 16 "}, true, false},
+		{"mode == 'on' || size > 10", map[string]string{"mode": "off", "size": "20"}, true, false},
+		{"mode != \"on\" && size > 10", map[string]string{"mode": "on", "size": "20"}, false, false},
+		{"!(a > 1)", map[string]string{"a": "0"}, true, false},
+		{"-a + 2 * 3 == 4", map[string]string{"a": "2"}, true, false},
+		{"(1 + 2) * 3 == 9 && 10 % 4 == 2", nil, true, false},
+		{"min(a, 5) + max(a, 5) + abs(-1) == 11", map[string]string{"a": "3"}, false, false},
+		{"min(a, 5) + max(a, 5) + abs(-1) == 9", map[string]string{"a": "3"}, true, false},
+		{"mode == 1", map[string]string{"mode": "on"}, false, true},
+		{"mode > 'a'", map[string]string{"mode": "on"}, false, true},
+		{"a / b > 1", map[string]string{"a": "1", "b": "0"}, false, true},
+		{"unknown > 1", nil, false, true},
+		{"a + 1", map[string]string{"a": "1"}, false, true},
+		{"a && b", map[string]string{"a": "1", "b": "1"}, false, true},
+		{"pow(a, 2) > 1", map[string]string{"a": "2"}, false, true},
+		{"abs(a, 2) > 1", map[string]string{"a": "2"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			got, err := c.Check(tt.values, facts)
+			if tt.fail {
+				if err == nil {
+					t.Errorf("Check = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Check = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
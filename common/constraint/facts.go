@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package constraint
+
+import (
+	"bufio"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/log"
+)
+
+const memInfo = "/proc/meminfo"
+
+// HostFacts return the facts of the host which can be used in the constraints:
+// mem_total and mem_available in bytes, cpu_count and page_size in bytes
+func HostFacts() map[string]float64 {
+	facts := map[string]float64{
+		"cpu_count": float64(runtime.NumCPU()),
+		"page_size": float64(os.Getpagesize()),
+	}
+
+	file, err := os.Open(memInfo)
+	if err != nil {
+		log.Warnf("failed to read %s: %v", memInfo, err)
+		return facts
+	}
+	defer file.Close()
+
+	keys := map[string]string{"MemTotal": "mem_total", "MemAvailable": "mem_available"}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		name, ok := keys[strings.TrimSuffix(fields[0], ":")]
+		if !ok {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			continue
+		}
+		if len(fields) > 2 && strings.EqualFold(fields[2], "kB") {
+			value = value * 1024
+		}
+		facts[name] = value
+	}
+	return facts
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package extractor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// Spec : the extractor of the evaluation value from the benchmark output,
+// one of regex, jsonpath and parser must be set, the value is the first
+// capture group of the regex, the value at the jsonpath of the json output
+// such as jobs[0].read.iops, or the metric of the built-in parser
+type Spec struct {
+	Regex    string `yaml:"regex"`
+	JSONPath string `yaml:"jsonpath"`
+	Parser   string `yaml:"parser"`
+	Metric   string `yaml:"metric"`
+}
+
+// Check method check the extractor is valid
+func (s *Spec) Check() error {
+	set := 0
+	for _, value := range []string{s.Regex, s.JSONPath, s.Parser} {
+		if value != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of regex, jsonpath and parser must be set")
+	}
+
+	switch {
+	case s.Regex != "":
+		re, err := regexp.Compile(s.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex %q: %v", s.Regex, err)
+		}
+		if re.NumSubexp() < 1 {
+			return fmt.Errorf("regex %q has no capture group", s.Regex)
+		}
+	case s.JSONPath != "":
+		if _, err := parsePath(s.JSONPath); err != nil {
+			return err
+		}
+	default:
+		metrics, ok := parserMetrics[s.Parser]
+		if !ok {
+			return fmt.Errorf("unknown parser %s, the parsers are %v", s.Parser, Parsers())
+		}
+		if s.Metric == "" {
+			return fmt.Errorf("metric of parser %s must be set", s.Parser)
+		}
+		if metrics != nil && !utils.CheckValueInSlice(s.Metric, metrics) {
+			return fmt.Errorf("unknown metric %s of parser %s, the metrics are %v", s.Metric, s.Parser, metrics)
+		}
+	}
+	return nil
+}
+
+// Extract method return the evaluation value from the benchmark output
+func (s *Spec) Extract(output []byte) (float64, error) {
+	switch {
+	case s.Regex != "":
+		return extractRegex(s.Regex, output)
+	case s.JSONPath != "":
+		return extractJSONPath(s.JSONPath, output)
+	case s.Parser != "":
+		parser, ok := parsers[s.Parser]
+		if !ok {
+			return 0, fmt.Errorf("unknown parser %s", s.Parser)
+		}
+		metrics, err := parser(output)
+		if err != nil {
+			return 0, fmt.Errorf("%s parser: %v", s.Parser, err)
+		}
+		value, ok := metrics[s.Metric]
+		if !ok {
+			return 0, fmt.Errorf("metric %s is not found in the %s output", s.Metric, s.Parser)
+		}
+		return value, nil
+	}
+	return 0, fmt.Errorf("no extractor is set")
+}
+
+// Parsers return the names of the built-in parsers
+func Parsers() []string {
+	names := make([]string, 0, len(parsers))
+	for name := range parsers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func extractRegex(expr string, output []byte) (float64, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid regex %q: %v", expr, err)
+	}
+	match := re.FindSubmatch(output)
+	if len(match) < 2 {
+		return 0, fmt.Errorf("regex %q does not match the output", expr)
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(string(match[1])), 64)
+	if err != nil {
+		return 0, fmt.Errorf("the capture %q of regex %q is not a number", match[1], expr)
+	}
+	return value, nil
+}
+
+// pathStep : the step of the json path, the key of the object or the index
+// of the array
+type pathStep struct {
+	key   string
+	index int
+}
+
+var pathIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+func parsePath(path string) ([]pathStep, error) {
+	path = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(path), "$"), ".")
+	if path == "" {
+		return nil, fmt.Errorf("jsonpath is empty")
+	}
+	steps := make([]pathStep, 0)
+	for _, part := range strings.Split(path, ".") {
+		key := part
+		if pos := strings.Index(part, "["); pos >= 0 {
+			key = part[:pos]
+			indexes := pathIndex.FindAllStringSubmatch(part[pos:], -1)
+			if pathIndex.ReplaceAllString(part[pos:], "") != "" || len(indexes) == 0 {
+				return nil, fmt.Errorf("invalid jsonpath %s", path)
+			}
+			if key != "" {
+				steps = append(steps, pathStep{key: key, index: -1})
+			}
+			for _, index := range indexes {
+				n, _ := strconv.Atoi(index[1])
+				steps = append(steps, pathStep{index: n})
+			}
+			continue
+		}
+		if key == "" {
+			return nil, fmt.Errorf("invalid jsonpath %s", path)
+		}
+		steps = append(steps, pathStep{key: key, index: -1})
+	}
+	return steps, nil
+}
+
+func extractJSONPath(path string, output []byte) (float64, error) {
+	steps, err := parsePath(path)
+	if err != nil {
+		return 0, err
+	}
+	var value interface{}
+	if err := decodeJSON(output, &value); err != nil {
+		return 0, err
+	}
+	for _, step := range steps {
+		switch current := value.(type) {
+		case map[string]interface{}:
+			if step.key == "" {
+				return 0, fmt.Errorf("jsonpath %s indexes an object", path)
+			}
+			var ok bool
+			if value, ok = current[step.key]; !ok {
+				return 0, fmt.Errorf("key %s of jsonpath %s is not found", step.key, path)
+			}
+		case []interface{}:
+			if step.key != "" || step.index >= len(current) {
+				return 0, fmt.Errorf("index of jsonpath %s is out of range", path)
+			}
+			value = current[step.index]
+		default:
+			return 0, fmt.Errorf("jsonpath %s goes through a value", path)
+		}
+	}
+	return toFloat(value, path)
+}
+
+// decodeJSON decode the json in the output, the text before the json such as
+// the warnings of the benchmark is ignored
+func decodeJSON(output []byte, value interface{}) error {
+	start := bytes.IndexAny(output, "{[")
+	if start < 0 {
+		return fmt.Errorf("no json is found in the output")
+	}
+	if err := json.NewDecoder(bytes.NewReader(output[start:])).Decode(value); err != nil {
+		return fmt.Errorf("failed to decode the json output: %v", err)
+	}
+	return nil
+}
+
+func toFloat(value interface{}, path string) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		number, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("the value %q of %s is not a number", v, path)
+		}
+		return number, nil
+	}
+	return 0, fmt.Errorf("the value of %s is not a number", path)
+}
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package extractor
+
+import (
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  Spec
+		valid bool
+	}{
+		{"regex", Spec{Regex: `tps: ([\d.]+)`}, true},
+		{"regex without group", Spec{Regex: `tps: [\d.]+`}, false},
+		{"invalid regex", Spec{Regex: `tps: ([\d.]+`}, false},
+		{"jsonpath", Spec{JSONPath: "jobs[0].read.iops"}, true},
+		{"jsonpath with root", Spec{JSONPath: "$.jobs[0].read.iops"}, true},
+		{"invalid jsonpath", Spec{JSONPath: "jobs[x].read"}, false},
+		{"empty jsonpath", Spec{JSONPath: "$"}, false},
+		{"parser", Spec{Parser: "sysbench", Metric: "tps"}, true},
+		{"parser with any metric", Spec{Parser: "redis-benchmark", Metric: "mset_10_keys"}, true},
+		{"unknown parser", Spec{Parser: "ab", Metric: "tps"}, false},
+		{"unknown metric", Spec{Parser: "wrk", Metric: "tps"}, false},
+		{"parser without metric", Spec{Parser: "fio"}, false},
+		{"nothing set", Spec{}, false},
+		{"two set", Spec{Regex: `tps: ([\d.]+)`, Parser: "sysbench", Metric: "tps"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spec.Check()
+			if tt.valid && err != nil {
+				t.Errorf("Check() failed: %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Errorf("Check() succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   Spec
+		output string
+		value  float64
+		valid  bool
+	}{
+		{"regex", Spec{Regex: `transactions:\s+\d+\s+\(([\d.]+) per sec`}, sysbenchOutput, 499.52, true},
+		{"regex no match", Spec{Regex: `tps: ([\d.]+)`}, sysbenchOutput, 0, false},
+		{"regex not a number", Spec{Regex: `total time:\s+(\S+)`}, sysbenchOutput, 0, false},
+		{"jsonpath after warning", Spec{JSONPath: "jobs[1].read.iops"}, fioOutput, 4999.75, true},
+		{"jsonpath string value", Spec{JSONPath: "result.tps"}, `{"result": {"tps": " 12.5 "}}`, 12.5, true},
+		{"jsonpath root array", Spec{JSONPath: "[1].qps"}, `[{"qps": 1}, {"qps": 2}]`, 2, true},
+		{"jsonpath missing key", Spec{JSONPath: "jobs[0].trim.iops"}, fioOutput, 0, false},
+		{"jsonpath out of range", Spec{JSONPath: "jobs[2].read.iops"}, fioOutput, 0, false},
+		{"jsonpath not a number", Spec{JSONPath: "jobs[0].jobname"}, fioOutput, 0, false},
+		{"jsonpath no json", Spec{JSONPath: "tps"}, "connection refused", 0, false},
+		{"parser", Spec{Parser: "wrk", Metric: "requests_per_sec"}, wrkOutput, 748868.53, true},
+		{"parser missing metric", Spec{Parser: "redis-benchmark", Metric: "lpush"}, redisQuietOutput, 0, false},
+		{"parser failed", Spec{Parser: "memtier", Metric: "ops_per_sec"}, "connection refused", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := tt.spec.Extract([]byte(tt.output))
+			if !tt.valid {
+				if err == nil {
+					t.Errorf("Extract() = %v, want an error", value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Extract() failed: %v", err)
+			}
+			if value != tt.value {
+				t.Errorf("Extract() = %v, want %v", value, tt.value)
+			}
+		})
+	}
+}
+
+func TestParsersList(t *testing.T) {
+	names := Parsers()
+	if len(names) != len(parserMetrics) {
+		t.Fatalf("Parsers() = %v, the metrics are defined for %d parsers", names, len(parserMetrics))
+	}
+	for i, name := range names {
+		if _, ok := parserMetrics[name]; !ok {
+			t.Errorf("the metrics of parser %s are not defined", name)
+		}
+		if i > 0 && names[i-1] >= name {
+			t.Errorf("Parsers() = %v is not sorted", names)
+		}
+	}
+}
@@ -0,0 +1,320 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package extractor
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Parser : the built-in parser of the benchmark output, it returns all the
+// metrics found in the output
+type Parser func(output []byte) (map[string]float64, error)
+
+// the built-in parsers of the benchmark output
+var parsers = map[string]Parser{
+	"sysbench":        ParseSysbench,
+	"fio":             ParseFio,
+	"redis-benchmark": ParseRedisBenchmark,
+	"wrk":             ParseWrk,
+	"memtier":         ParseMemtier,
+}
+
+// parserMetrics : the metrics of the parsers, nil if the metrics depend on
+// the output, such as the tests of redis-benchmark
+var parserMetrics = map[string][]string{
+	"sysbench": {"tps", "qps", "events_per_sec", "latency_min", "latency_avg", "latency_max",
+		"latency_p95", "latency_p99"},
+	"fio": {"read_iops", "read_bw", "read_lat_mean", "read_clat_p99", "write_iops", "write_bw",
+		"write_lat_mean", "write_clat_p99", "trim_iops", "trim_bw", "trim_lat_mean", "trim_clat_p99"},
+	"redis-benchmark": nil,
+	"wrk": {"requests_per_sec", "transfer_per_sec", "latency_avg", "latency_stdev", "latency_max",
+		"latency_p50", "latency_p75", "latency_p90", "latency_p99"},
+	"memtier": nil,
+}
+
+var (
+	sysbenchRate       = regexp.MustCompile(`^(transactions|queries):\s+\d+\s+\(([\d.]+) per sec\.\)`)
+	sysbenchEvents     = regexp.MustCompile(`^events per second:\s+([\d.]+)`)
+	sysbenchLatency    = regexp.MustCompile(`^(min|avg|max):\s+([\d.]+)`)
+	sysbenchPercentile = regexp.MustCompile(`^(\d+)th percentile:\s+([\d.]+)`)
+)
+
+// ParseSysbench parse the output of sysbench, the metrics are tps, qps,
+// events_per_sec and the latency in ms: latency_min, latency_avg,
+// latency_max and latency_pNN of the percentile
+func ParseSysbench(output []byte) (map[string]float64, error) {
+	metrics := make(map[string]float64)
+	latency := false
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "Latency") {
+			latency = true
+			continue
+		}
+		if match := sysbenchRate.FindStringSubmatch(line); match != nil {
+			name := "tps"
+			if match[1] == "queries" {
+				name = "qps"
+			}
+			metrics[name], _ = strconv.ParseFloat(match[2], 64)
+		} else if match := sysbenchEvents.FindStringSubmatch(line); match != nil {
+			metrics["events_per_sec"], _ = strconv.ParseFloat(match[1], 64)
+		} else if match := sysbenchLatency.FindStringSubmatch(line); match != nil && latency {
+			metrics["latency_"+match[1]], _ = strconv.ParseFloat(match[2], 64)
+		} else if match := sysbenchPercentile.FindStringSubmatch(line); match != nil && latency {
+			metrics["latency_p"+match[1]], _ = strconv.ParseFloat(match[2], 64)
+		}
+	}
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("no metric is found")
+	}
+	return metrics, nil
+}
+
+// fioStat : the statistics of the direction of the fio job
+type fioStat struct {
+	Iops     float64 `json:"iops"`
+	Bw       float64 `json:"bw"`
+	TotalIos float64 `json:"total_ios"`
+	LatNs    struct {
+		Mean float64 `json:"mean"`
+	} `json:"lat_ns"`
+	ClatNs struct {
+		Percentile map[string]float64 `json:"percentile"`
+	} `json:"clat_ns"`
+}
+
+// ParseFio parse the json output of fio, the metrics of read, write and
+// trim are the sum of the iops and the bw in KiB/s of all jobs, the mean
+// latency in us weighted by the ios, and the max clat percentile in us of
+// all jobs, such as read_iops, write_bw, read_lat_mean and write_clat_p99
+func ParseFio(output []byte) (map[string]float64, error) {
+	var result struct {
+		Jobs []map[string]json.RawMessage `json:"jobs"`
+	}
+	if err := decodeJSON(output, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Jobs) == 0 {
+		return nil, fmt.Errorf("no job is found")
+	}
+
+	metrics := make(map[string]float64)
+	for _, direction := range []string{"read", "write", "trim"} {
+		ios, latSum := 0.0, 0.0
+		for _, job := range result.Jobs {
+			raw, ok := job[direction]
+			if !ok {
+				continue
+			}
+			stat := new(fioStat)
+			if err := json.Unmarshal(raw, stat); err != nil {
+				return nil, fmt.Errorf("failed to decode the %s statistics: %v", direction, err)
+			}
+			metrics[direction+"_iops"] += stat.Iops
+			metrics[direction+"_bw"] += stat.Bw
+			ios += stat.TotalIos
+			latSum += stat.LatNs.Mean / 1000 * stat.TotalIos
+			for key, value := range stat.ClatNs.Percentile {
+				percent, err := strconv.ParseFloat(key, 64)
+				if err != nil {
+					continue
+				}
+				name := direction + "_clat_p" + strconv.FormatFloat(percent, 'f', -1, 64)
+				metrics[name] = math.Max(metrics[name], value/1000)
+			}
+		}
+		if ios > 0 {
+			metrics[direction+"_lat_mean"] = latSum / ios
+		}
+	}
+	return metrics, nil
+}
+
+var (
+	redisTitle = regexp.MustCompile(`^====== (.+) ======$`)
+	redisRate  = regexp.MustCompile(`^(?:throughput summary:\s+|([^:]+):\s+)?([\d.]+) requests per second`)
+	redisCSV   = regexp.MustCompile(`^"([^"]+)","([\d.]+)"`)
+	redisName  = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// ParseRedisBenchmark parse the output of redis-benchmark in the default,
+// quiet (-q) or csv (--csv) format, the metric is the requests per second
+// of the test, whose name is lowercase and joined by underscores, such as
+// set, get, lpush and mset_10_keys
+func ParseRedisBenchmark(output []byte) (map[string]float64, error) {
+	metrics := make(map[string]float64)
+	test := ""
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		// the progress of redis-benchmark is refreshed by \r in one line
+		if pos := strings.LastIndex(line, "\r"); pos >= 0 {
+			line = strings.TrimSpace(line[pos+1:])
+		}
+		if match := redisTitle.FindStringSubmatch(line); match != nil {
+			test = match[1]
+			continue
+		}
+		if match := redisCSV.FindStringSubmatch(line); match != nil {
+			metrics[redisMetric(match[1])], _ = strconv.ParseFloat(match[2], 64)
+			continue
+		}
+		if match := redisRate.FindStringSubmatch(line); match != nil {
+			name := match[1]
+			if name == "" {
+				name = test
+			}
+			if name == "" {
+				continue
+			}
+			metrics[redisMetric(name)], _ = strconv.ParseFloat(match[2], 64)
+		}
+	}
+	if len(metrics) == 0 {
+		return nil, fmt.Errorf("no test is found")
+	}
+	return metrics, nil
+}
+
+func redisMetric(test string) string {
+	return strings.Trim(redisName.ReplaceAllString(strings.ToLower(test), "_"), "_")
+}
+
+var (
+	wrkLatency      = regexp.MustCompile(`^Latency\s+([\d.]+\w+)\s+([\d.]+\w+)\s+([\d.]+\w+)`)
+	wrkDistribution = regexp.MustCompile(`^([\d.]+)%\s+([\d.]+\w+)$`)
+	wrkRequests     = regexp.MustCompile(`^Requests/sec:\s+([\d.]+)`)
+	wrkTransfer     = regexp.MustCompile(`^Transfer/sec:\s+([\d.]+\w+)`)
+	wrkValue        = regexp.MustCompile(`^([\d.]+)([a-zA-Z]*)$`)
+)
+
+// ParseWrk parse the output of wrk, the metrics are requests_per_sec,
+// transfer_per_sec in bytes, and the latency in ms: latency_avg,
+// latency_stdev, latency_max and latency_pNN of the --latency distribution
+func ParseWrk(output []byte) (map[string]float64, error) {
+	metrics := make(map[string]float64)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		var err error
+		if match := wrkLatency.FindStringSubmatch(line); match != nil {
+			for index, name := range []string{"latency_avg", "latency_stdev", "latency_max"} {
+				if metrics[name], err = wrkDuration(match[index+1]); err != nil {
+					return nil, err
+				}
+			}
+		} else if match := wrkDistribution.FindStringSubmatch(line); match != nil {
+			percent, _ := strconv.ParseFloat(match[1], 64)
+			name := "latency_p" + strconv.FormatFloat(percent, 'f', -1, 64)
+			if metrics[name], err = wrkDuration(match[2]); err != nil {
+				return nil, err
+			}
+		} else if match := wrkRequests.FindStringSubmatch(line); match != nil {
+			metrics["requests_per_sec"], _ = strconv.ParseFloat(match[1], 64)
+		} else if match := wrkTransfer.FindStringSubmatch(line); match != nil {
+			if metrics["transfer_per_sec"], err = wrkSize(match[1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if _, ok := metrics["requests_per_sec"]; !ok {
+		return nil, fmt.Errorf("Requests/sec is not found")
+	}
+	return metrics, nil
+}
+
+// wrkDuration convert the duration of wrk, such as 635.91us, to ms
+func wrkDuration(value string) (float64, error) {
+	units := map[string]float64{"us": 0.001, "ms": 1, "s": 1000, "m": 60000, "h": 3600000}
+	return wrkUnit(value, units)
+}
+
+// wrkSize convert the size of wrk, such as 606.33MB, to bytes
+func wrkSize(value string) (float64, error) {
+	units := map[string]float64{"": 1, "B": 1, "KB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30, "TB": 1 << 40}
+	return wrkUnit(value, units)
+}
+
+func wrkUnit(value string, units map[string]float64) (float64, error) {
+	match := wrkValue.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("invalid value %s", value)
+	}
+	scale, ok := units[match[2]]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit of %s", value)
+	}
+	number, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %s", value)
+	}
+	return number * scale, nil
+}
+
+var (
+	memtierColumn = regexp.MustCompile(`\s{2,}`)
+	memtierName   = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// ParseMemtier parse the output of memtier_benchmark, the metrics are the
+// columns of the Totals row, such as ops_per_sec, hits_per_sec, kb_per_sec,
+// and the latency in ms: latency_avg, latency_p50, latency_p99 and latency_p99.9
+func ParseMemtier(output []byte) (map[string]float64, error) {
+	var columns []string
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "Type ") {
+			columns = memtierColumn.Split(line, -1)
+			continue
+		}
+		if !strings.HasPrefix(line, "Totals ") || columns == nil {
+			continue
+		}
+		fields := strings.Fields(line)
+		metrics := make(map[string]float64)
+		for index := 1; index < len(columns) && index < len(fields); index++ {
+			value, err := strconv.ParseFloat(fields[index], 64)
+			if err != nil {
+				continue
+			}
+			metrics[memtierMetric(columns[index])] = value
+		}
+		return metrics, nil
+	}
+	return nil, fmt.Errorf("the Totals row is not found")
+}
+
+// memtierMetric return the metric name of the column, such as Ops/sec,
+// Avg. Latency and p99.9 Latency are ops_per_sec, latency_avg and latency_p99.9
+func memtierMetric(column string) string {
+	column = strings.ToLower(strings.TrimSpace(column))
+	if column == "latency" {
+		return "latency_avg"
+	}
+	if strings.HasSuffix(column, " latency") {
+		return "latency_" + strings.TrimSuffix(strings.TrimSuffix(column, " latency"), ".")
+	}
+	column = strings.Replace(column, "/", " per ", -1)
+	return strings.Trim(memtierName.ReplaceAllString(column, "_"), "_")
+}
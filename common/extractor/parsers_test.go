@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package extractor
+
+import (
+	"math"
+	"testing"
+)
+
+const sysbenchOutput = `sysbench 1.0.20 (using bundled LuaJIT 2.1.0-beta2)
+
+Running the test with following options:
+Number of threads: 16
+Initializing random number generator from current time
+
+Initializing worker threads...
+
+Threads started!
+
+SQL statistics:
+    queries performed:
+        read:                            140000
+        write:                           40000
+        other:                           20000
+        total:                           200000
+    transactions:                        10000  (499.52 per sec.)
+    queries:                             200000 (9990.40 per sec.)
+    ignored errors:                      0      (0.00 per sec.)
+    reconnects:                          0      (0.00 per sec.)
+
+General statistics:
+    total time:                          20.0182s
+    total number of events:              10000
+
+Latency (ms):
+         min:                                    5.12
+         avg:                                   32.01
+         max:                                  210.47
+         95th percentile:                       58.92
+         sum:                               320104.36
+
+Threads fairness:
+    events (avg/stddev):           625.0000/9.41
+    execution time (avg/stddev):   20.0065/0.01
+`
+
+const sysbenchCPUOutput = `CPU speed:
+    events per second:  1234.56
+
+General statistics:
+    total time:                          10.0008s
+    total number of events:              12347
+
+Latency (ms):
+         min:                                    0.79
+         avg:                                    0.81
+         max:                                    2.15
+         99th percentile:                        0.87
+         sum:                                 9998.12
+`
+
+const fioOutput = `note: both iodepth >= 1 and synchronous I/O engine are selected, queue depth will be capped at 1
+{
+  "fio version" : "fio-3.29",
+  "timestamp" : 1697500000,
+  "jobs" : [
+    {
+      "jobname" : "randrw",
+      "groupid" : 0,
+      "error" : 0,
+      "read" : {
+        "io_bytes" : 409600000,
+        "bw" : 20000,
+        "iops" : 5000.25,
+        "total_ios" : 100000,
+        "lat_ns" : {
+          "min" : 50000,
+          "max" : 9000000,
+          "mean" : 200000.0,
+          "stddev" : 15000.0
+        },
+        "clat_ns" : {
+          "percentile" : {
+            "50.000000" : 180224,
+            "99.000000" : 850000,
+            "99.900000" : 2000000
+          }
+        }
+      },
+      "write" : {
+        "io_bytes" : 204800000,
+        "bw" : 10000,
+        "iops" : 2500.5,
+        "total_ios" : 50000,
+        "lat_ns" : {
+          "mean" : 400000.0
+        },
+        "clat_ns" : {
+          "percentile" : {
+            "99.000000" : 1200000
+          }
+        }
+      }
+    },
+    {
+      "jobname" : "randrw",
+      "groupid" : 0,
+      "error" : 0,
+      "read" : {
+        "bw" : 20000,
+        "iops" : 4999.75,
+        "total_ios" : 300000,
+        "lat_ns" : {
+          "mean" : 600000.0
+        },
+        "clat_ns" : {
+          "percentile" : {
+            "99.000000" : 950000
+          }
+        }
+      },
+      "write" : {
+        "bw" : 0,
+        "iops" : 0,
+        "total_ios" : 0,
+        "lat_ns" : {
+          "mean" : 0.0
+        },
+        "clat_ns" : {
+        }
+      }
+    }
+  ]
+}
+`
+
+const redisDefaultOutput = `====== SET ======
+  100000 requests completed in 1.00 seconds
+  50 parallel clients
+  3 bytes payload
+  keep alive: 1
+  host configuration "save": 3600 1 300 100 60 10000
+  host configuration "appendonly": no
+  multi-thread: no
+
+Latency by percentile distribution:
+0.000% <= 0.151 milliseconds (cumulative count 1)
+50.000% <= 0.255 milliseconds (cumulative count 51234)
+100.000% <= 1.407 milliseconds (cumulative count 100000)
+
+Summary:
+  throughput summary: 99502.49 requests per second
+  latency summary (msec):
+          avg       min       p50       p95       p99       max
+        0.262     0.144     0.255     0.351     0.431     1.407
+
+====== GET ======
+  100000 requests completed in 0.95 seconds
+  50 parallel clients
+  3 bytes payload
+
+Summary:
+  throughput summary: 105263.16 requests per second
+`
+
+const redisLegacyOutput = `====== LPUSH ======
+  100000 requests completed in 1.13 seconds
+  50 parallel clients
+  3 bytes payload
+  keep alive: 1
+
+99.91% <= 1 milliseconds
+100.00% <= 1 milliseconds
+88495.58 requests per second
+
+`
+
+const redisQuietOutput = "PING_INLINE: rps=0.0 (overall: nan) avg_msec=nan (overall: nan)\r" +
+	"PING_INLINE: 123456.79 requests per second, p50=0.207 msec\n" +
+	"PING_MBULK: 131578.95 requests per second, p50=0.199 msec\n" +
+	"SET: 117647.06 requests per second, p50=0.215 msec\n" +
+	"MSET (10 keys): 55248.62 requests per second, p50=0.463 msec\n"
+
+const redisCSVOutput = `"test","rps","avg_latency_ms","min_latency_ms","p50_latency_ms","p95_latency_ms","p99_latency_ms","max_latency_ms"
+"PING_INLINE","121951.22","0.228","0.080","0.223","0.303","0.399","1.095"
+"SET","116279.07","0.240","0.088","0.231","0.327","0.447","1.183"
+"LRANGE_100 (first 100 elements)","40816.33","0.651","0.176","0.639","0.847","1.031","2.383"
+`
+
+const wrkOutput = `Running 30s test @ http://127.0.0.1:8080/index.html
+  12 threads and 400 connections
+  Thread Stats   Avg      Stdev     Max   +/- Stdev
+    Latency   635.91us    0.89ms  12.92ms   93.69%
+    Req/Sec    56.20k     8.07k   62.00k    86.54%
+  Latency Distribution
+     50%  250.00us
+     75%  491.00us
+     90%  700.00us
+     99%    5.80ms
+  22464657 requests in 30.00s, 17.76GB read
+Requests/sec: 748868.53
+Transfer/sec:    606.33MB
+`
+
+const memtierOutput = `4         Threads
+50        Connections per thread
+10000     Requests per client
+
+
+ALL STATS
+============================================================================================================================
+Type         Ops/sec     Hits/sec   Misses/sec    Avg. Latency     p50 Latency     p99 Latency   p99.9 Latency       KB/sec
+----------------------------------------------------------------------------------------------------------------------------
+Sets          909.09          ---          ---         0.52100         0.49500         1.27100         2.00700        70.12
+Gets         9090.91      4545.45      4545.46         0.51800         0.49500         1.25500         1.99900       351.68
+Waits           0.00          ---          ---             ---             ---             ---             ---          ---
+Totals      10000.00      4545.45      4545.46         0.51827         0.49500         1.26300         1.99900       421.80
+`
+
+func TestParsers(t *testing.T) {
+	tests := []struct {
+		name    string
+		parser  Parser
+		output  string
+		metrics map[string]float64
+	}{
+		{"sysbench oltp", ParseSysbench, sysbenchOutput, map[string]float64{
+			"tps": 499.52, "qps": 9990.40, "latency_min": 5.12, "latency_avg": 32.01,
+			"latency_max": 210.47, "latency_p95": 58.92}},
+		{"sysbench cpu", ParseSysbench, sysbenchCPUOutput, map[string]float64{
+			"events_per_sec": 1234.56, "latency_avg": 0.81, "latency_p99": 0.87}},
+		{"fio json", ParseFio, fioOutput, map[string]float64{
+			"read_iops": 10000, "read_bw": 40000, "read_lat_mean": 500, "read_clat_p99": 950,
+			"read_clat_p99.9": 2000, "read_clat_p50": 180.224, "write_iops": 2500.5, "write_bw": 10000,
+			"write_lat_mean": 400, "write_clat_p99": 1200}},
+		{"redis-benchmark default", ParseRedisBenchmark, redisDefaultOutput, map[string]float64{
+			"set": 99502.49, "get": 105263.16}},
+		{"redis-benchmark legacy", ParseRedisBenchmark, redisLegacyOutput, map[string]float64{
+			"lpush": 88495.58}},
+		{"redis-benchmark quiet", ParseRedisBenchmark, redisQuietOutput, map[string]float64{
+			"ping_inline": 123456.79, "ping_mbulk": 131578.95, "set": 117647.06, "mset_10_keys": 55248.62}},
+		{"redis-benchmark csv", ParseRedisBenchmark, redisCSVOutput, map[string]float64{
+			"ping_inline": 121951.22, "set": 116279.07, "lrange_100_first_100_elements": 40816.33}},
+		{"wrk", ParseWrk, wrkOutput, map[string]float64{
+			"requests_per_sec": 748868.53, "transfer_per_sec": 606.33 * (1 << 20), "latency_avg": 0.63591,
+			"latency_stdev": 0.89, "latency_max": 12.92, "latency_p50": 0.25, "latency_p99": 5.8}},
+		{"memtier", ParseMemtier, memtierOutput, map[string]float64{
+			"ops_per_sec": 10000, "hits_per_sec": 4545.45, "misses_per_sec": 4545.46,
+			"latency_avg": 0.51827, "latency_p50": 0.495, "latency_p99": 1.263,
+			"latency_p99.9": 1.999, "kb_per_sec": 421.8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metrics, err := tt.parser([]byte(tt.output))
+			if err != nil {
+				t.Fatalf("parse failed: %v", err)
+			}
+			for name, want := range tt.metrics {
+				got, ok := metrics[name]
+				if !ok {
+					t.Errorf("metric %s is not found in %v", name, metrics)
+					continue
+				}
+				if math.Abs(got-want) > 1e-6*math.Max(math.Abs(want), 1) {
+					t.Errorf("metric %s = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParsersInvalidOutput(t *testing.T) {
+	tests := []struct {
+		name   string
+		parser Parser
+		output string
+	}{
+		{"sysbench", ParseSysbench, "FATAL: unable to connect to MySQL server on host 'localhost'\n"},
+		{"fio", ParseFio, "fio: pid=0, err=2/file:filesetup.c:174, func=open, error=No such file\n"},
+		{"fio no job", ParseFio, `{"fio version" : "fio-3.29", "jobs" : []}`},
+		{"redis-benchmark", ParseRedisBenchmark, "Could not connect to Redis at 127.0.0.1:6379: Connection refused\n"},
+		{"wrk", ParseWrk, "unable to connect to 127.0.0.1:8080 Connection refused\n"},
+		{"wrk unit", ParseWrk, "    Latency   635.91xs    0.89ms  12.92ms   93.69%\nRequests/sec: 1.0\n"},
+		{"memtier", ParseMemtier, "connection refused (127.0.0.1:6379)\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if metrics, err := tt.parser([]byte(tt.output)); err == nil {
+				t.Errorf("parse succeeded with %v, want an error", metrics)
+			}
+		})
+	}
+}
+
+func TestMemtierMetric(t *testing.T) {
+	tests := map[string]string{
+		"Ops/sec":       "ops_per_sec",
+		"KB/sec":        "kb_per_sec",
+		"Latency":       "latency_avg",
+		"Avg. Latency":  "latency_avg",
+		"p50 Latency":   "latency_p50",
+		"p99.9 Latency": "latency_p99.9",
+	}
+	for column, want := range tests {
+		if got := memtierMetric(column); got != want {
+			t.Errorf("memtierMetric(%q) = %s, want %s", column, got, want)
+		}
+	}
+}
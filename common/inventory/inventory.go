@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package inventory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gitee.com/openeuler/A-Tune/common/client"
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// TLS : the certificates to connect the atuned of the node
+type TLS struct {
+	CaFile     string `yaml:"ca"`
+	CertFile   string `yaml:"cert"`
+	KeyFile    string `yaml:"key"`
+	ServerName string `yaml:"server_name"`
+}
+
+// Node : the atune node in the cluster
+type Node struct {
+	Address string `yaml:"address"`
+	Port    string `yaml:"port"`
+	TLS     *TLS   `yaml:"tls"`
+}
+
+// Group : the named group of the nodes, the knobs of the group have the
+// same values on all its nodes
+type Group struct {
+	Name  string  `yaml:"name"`
+	Role  string  `yaml:"role"`
+	Nodes []*Node `yaml:"nodes"`
+}
+
+// Inventory : the cluster inventory, the port and tls are the defaults
+// of the nodes
+type Inventory struct {
+	Port   string   `yaml:"port"`
+	TLS    *TLS     `yaml:"tls"`
+	Groups []*Group `yaml:"groups"`
+}
+
+var (
+	once    sync.Once
+	current *Inventory
+	loadErr error
+)
+
+// Current method return the cluster inventory of atuned.cnf, the inventory
+// is converted from connect if the inventory file is not set, it is nil
+// if atuned is not deployed in a cluster
+func Current() (*Inventory, error) {
+	once.Do(func() {
+		if strings.TrimSpace(config.Inventory) != "" {
+			current, loadErr = Load(config.Inventory)
+			return
+		}
+		if strings.TrimSpace(config.Connect) != "" {
+			current = FromConnect(config.Connect)
+		}
+	})
+	return current, loadErr
+}
+
+// Load method load and check the inventory yaml file
+func Load(file string) (*Inventory, error) {
+	inv := new(Inventory)
+	if err := utils.ParseFile(file, "yaml", inv); err != nil {
+		return nil, fmt.Errorf("load inventory %s failed, err: %v", file, err)
+	}
+	if err := inv.check(); err != nil {
+		return nil, fmt.Errorf("invalid inventory %s: %v", file, err)
+	}
+	return inv, nil
+}
+
+// FromConnect method convert the connect of atuned.cnf, such as
+// ip1,ip2-ip3, to the inventory whose groups are named 0, 1 and so on
+func FromConnect(connect string) *Inventory {
+	inv := &Inventory{Port: config.Port}
+	for index, ips := range strings.Split(strings.TrimSpace(connect), "-") {
+		group := &Group{Name: strconv.Itoa(index)}
+		for _, ip := range strings.Split(strings.TrimSpace(ips), ",") {
+			if strings.TrimSpace(ip) == "" {
+				continue
+			}
+			group.Nodes = append(group.Nodes, &Node{Address: strings.TrimSpace(ip), Port: config.Port})
+		}
+		inv.Groups = append(inv.Groups, group)
+	}
+	return inv
+}
+
+func (inv *Inventory) check() error {
+	if len(inv.Groups) == 0 {
+		return fmt.Errorf("no group is defined")
+	}
+	names := make(map[string]struct{})
+	for _, group := range inv.Groups {
+		if group.Name == "" || strings.ContainsAny(group.Name, ", \t") {
+			return fmt.Errorf("invalid group name %q", group.Name)
+		}
+		if _, ok := names[group.Name]; ok {
+			return fmt.Errorf("group %s is duplicated", group.Name)
+		}
+		names[group.Name] = struct{}{}
+		for _, node := range group.Nodes {
+			if strings.TrimSpace(node.Address) == "" {
+				return fmt.Errorf("the address of the node in group %s is empty", group.Name)
+			}
+			if node.Port == "" {
+				node.Port = inv.Port
+			}
+			if node.Port == "" {
+				node.Port = config.Port
+			}
+			if node.TLS == nil {
+				node.TLS = inv.TLS
+			}
+		}
+	}
+	return nil
+}
+
+// Match method return the groups whose name or role is in names, all the
+// groups are returned if names is empty
+func (inv *Inventory) Match(names []string) []*Group {
+	if len(names) == 0 {
+		return inv.Groups
+	}
+	groups := make([]*Group, 0)
+	for _, group := range inv.Groups {
+		if utils.CheckValueInSlice(group.Name, names) ||
+			group.Role != "" && utils.CheckValueInSlice(group.Role, names) {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// Node method return the node of the address, the node of the default port
+// is returned if it is not in the inventory
+func (inv *Inventory) Node(address string) *Node {
+	if inv != nil {
+		for _, group := range inv.Groups {
+			for _, node := range group.Nodes {
+				if node.Address == address {
+					return node
+				}
+			}
+		}
+	}
+	return &Node{Address: address, Port: config.Port}
+}
+
+// Addresses method return the addresses of the nodes of the group
+func (g *Group) Addresses() []string {
+	addresses := make([]string, 0, len(g.Nodes))
+	for _, node := range g.Nodes {
+		addresses = append(addresses, node.Address)
+	}
+	return addresses
+}
+
+// Connect method create the grpc client of the atuned on the node
+func (n *Node) Connect() (*client.Client, error) {
+	if n.TLS == nil {
+		return client.NewClient(n.Address, n.Port)
+	}
+	return client.NewClient(n.Address, n.Port,
+		client.WithTLS(n.TLS.CaFile, n.TLS.CertFile, n.TLS.KeyFile, n.TLS.ServerName))
+}
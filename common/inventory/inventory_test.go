@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package inventory
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"gitee.com/openeuler/A-Tune/common/config"
+)
+
+const testInventory = `
+port: "60001"
+tls:
+  ca: /etc/atuned/ca.crt
+groups:
+  - name: web
+    role: frontend
+    nodes:
+      - address: 192.168.1.2
+      - address: 192.168.1.3
+        port: "60002"
+        tls:
+          ca: /etc/atuned/web.crt
+  - name: db
+    nodes:
+      - address: 192.168.1.4
+`
+
+func loadInventory(t *testing.T, content string) (*Inventory, error) {
+	dir, err := ioutil.TempDir("", "inventory")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "inventory.yaml")
+	if err := ioutil.WriteFile(file, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return Load(file)
+}
+
+func TestLoad(t *testing.T) {
+	inv, err := loadInventory(t, testInventory)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	tests := []struct {
+		address string
+		port    string
+		ca      string
+	}{
+		{"192.168.1.2", "60001", "/etc/atuned/ca.crt"},
+		{"192.168.1.3", "60002", "/etc/atuned/web.crt"},
+		{"192.168.1.4", "60001", "/etc/atuned/ca.crt"},
+	}
+	for _, tt := range tests {
+		node := inv.Node(tt.address)
+		if node.Port != tt.port || node.TLS == nil || node.TLS.CaFile != tt.ca {
+			t.Errorf("node %s has port %s and tls %+v, want %s and %s", tt.address, node.Port, node.TLS, tt.port, tt.ca)
+		}
+	}
+	if node := inv.Node("10.0.0.1"); node.Port != config.Port || node.TLS != nil {
+		t.Errorf("the node out of the inventory is %+v, want the default port", node)
+	}
+
+	invalid := map[string]string{
+		"no groups":       "port: \"60001\"\n",
+		"empty name":      "groups:\n  - nodes:\n      - address: 1.1.1.1\n",
+		"name with comma": "groups:\n  - name: a,b\n",
+		"duplicate group": "groups:\n  - name: a\n  - name: a\n",
+		"empty address":   "groups:\n  - name: a\n    nodes:\n      - port: \"1\"\n",
+		"invalid yaml":    "groups: [\n",
+	}
+	for name, content := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := loadInventory(t, content); err == nil {
+				t.Errorf("Load succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestFromConnect(t *testing.T) {
+	tests := []struct {
+		connect string
+		groups  []string
+	}{
+		{"1.1.1.1", []string{"0:1.1.1.1"}},
+		{"1.1.1.1,1.1.1.2-1.1.1.3", []string{"0:1.1.1.1,1.1.1.2", "1:1.1.1.3"}},
+		{" 1.1.1.1 , ,1.1.1.2 ", []string{"0:1.1.1.1,1.1.1.2"}},
+	}
+	for _, tt := range tests {
+		inv := FromConnect(tt.connect)
+		groups := make([]string, 0, len(inv.Groups))
+		for _, group := range inv.Groups {
+			groups = append(groups, group.Name+":"+strings.Join(group.Addresses(), ","))
+		}
+		if strings.Join(groups, " ") != strings.Join(tt.groups, " ") {
+			t.Errorf("FromConnect(%q) = %v, want %v", tt.connect, groups, tt.groups)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	inv, err := loadInventory(t, testInventory)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	tests := []struct {
+		names  []string
+		groups []string
+	}{
+		{nil, []string{"web", "db"}},
+		{[]string{"db"}, []string{"db"}},
+		{[]string{"frontend"}, []string{"web"}},
+		{[]string{"frontend", "db"}, []string{"web", "db"}},
+		{[]string{"cache"}, []string{}},
+	}
+	for _, tt := range tests {
+		groups := make([]string, 0)
+		for _, group := range inv.Match(tt.names) {
+			groups = append(groups, group.Name)
+		}
+		if strings.Join(groups, ",") != strings.Join(tt.groups, ",") {
+			t.Errorf("Match(%v) = %v, want %v", tt.names, groups, tt.groups)
+		}
+	}
+}
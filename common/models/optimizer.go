@@ -38,16 +38,23 @@ type OptimizerPostBody struct {
 	FeatureSelector string     `json:"feature_selector"`
 }
 
-// Knob body store the tuning properties
+// InactiveValue is the value of the conditional knob in the params when
+// its condition is not satisfied, the knob is not set in the iteration
+const InactiveValue = "inactive"
+
+// Knob body store the tuning properties, the knob is active only when the
+// parent knob is active and its value is in the condition
 type Knob struct {
-	Dtype   string    `json:"dtype"`
-	Name    string    `json:"name"`
-	Options []string  `json:"options"`
-	Type    string    `json:"type"`
-	Range   []float32 `json:"range"`
-	Items   []float32 `json:"items"`
-	Step    float32   `json:"step"`
-	Ref     string    `json:"ref"`
+	Dtype     string    `json:"dtype"`
+	Name      string    `json:"name"`
+	Options   []string  `json:"options"`
+	Type      string    `json:"type"`
+	Range     []float64 `json:"range"`
+	Items     []float64 `json:"items"`
+	Step      float64   `json:"step"`
+	Ref       string    `json:"ref"`
+	Parent    string    `json:"parent,omitempty"`
+	Condition []string  `json:"condition,omitempty"`
 }
 
 // RespPostBody :the body returned of create optimizer task
@@ -65,6 +72,9 @@ type OptimizerPutBody struct {
 	Line	   string `json:"line"`
 	PrjName    string `json:"prj_name"`
 	MaxIter    int    `json:"max_iter"`
+	// Skip drops the params of the iteration without a value, only the
+	// native engines can skip, the python engine waits for the value
+	Skip bool `json:"-"`
 }
 
 // RespPutBody :the body returned of each optimizer iteration
@@ -0,0 +1,333 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// BayesName is the engine name of the native bayes optimizer
+const BayesName = "native-bayes"
+
+const (
+	defaultRandomStarts = 20
+	randomCandidates    = 1000
+	localCandidates     = 50
+	localCenters        = 5
+	explorationXi       = 0.01
+)
+
+// Bayes : the in process bayes optimizer, using gaussian process
+// with expected improvement as the acquisition function
+type Bayes struct {
+	space        *space
+	rng          *rand.Rand
+	maxEval      int
+	randomStarts int
+	noise        float64
+	selFeature   bool
+	baseline     []float64
+	points       [][]float64
+	values       []float64
+	seen         map[string]struct{}
+	evals        int
+	pending      []float64
+	finished     bool
+}
+
+// NewBayes create the native bayes engine
+func NewBayes() Engine {
+	return &Bayes{
+		rng:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		seen: make(map[string]struct{}),
+	}
+}
+
+// Post method build the search space and tell the history to the model
+func (b *Bayes) Post(body *models.OptimizerPostBody) (*models.RespPostBody, error) {
+	if body.MaxEval <= 0 {
+		return nil, fmt.Errorf("max eval must be greater than 0")
+	}
+	s, err := newSpace(body.Knobs)
+	if err != nil {
+		return nil, err
+	}
+	b.space = s
+	b.maxEval = int(body.MaxEval)
+	b.randomStarts = int(body.RandomStarts)
+	if b.randomStarts <= 0 {
+		b.randomStarts = defaultRandomStarts
+	}
+	b.noise = math.Max(body.Noise, 1e-6)
+	b.selFeature = body.FeatureFilter || body.SelFeature
+
+	refs := make([]string, 0, len(body.Knobs))
+	for _, knob := range body.Knobs {
+		refs = append(refs, knob.Name+"="+knob.Ref)
+	}
+	if b.baseline, err = b.space.parse(refs); err != nil {
+		log.Warnf("the ref value is not in the space, the baseline is not used: %v", err)
+		b.baseline = nil
+	}
+
+	if len(body.Xref) != len(body.Yref) {
+		return nil, fmt.Errorf("x_ref and y_ref should have the same length")
+	}
+	for i, xref := range body.Xref {
+		point, err := b.space.parse(xref)
+		if err != nil {
+			return nil, err
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(body.Yref[i]), 64)
+		if err != nil {
+			return nil, err
+		}
+		b.tell(point, value)
+		b.evals++
+	}
+	log.Infof("native bayes task created with %d knobs and %d history", len(b.space.dims), b.evals)
+
+	return &models.RespPostBody{Status: "OK", Iters: b.maxEval}, nil
+}
+
+// Put method tell the benchmark result and ask the next parameters
+func (b *Bayes) Put(body *models.OptimizerPutBody) (*models.RespPutBody, error) {
+	if b.space == nil {
+		return nil, fmt.Errorf("optimizer task is not created")
+	}
+	if body.Iterations == -1 {
+		return &models.RespPutBody{}, nil
+	}
+	if b.finished {
+		return nil, fmt.Errorf("optimizer task is finished")
+	}
+
+	if body.Skip {
+		if b.pending == nil {
+			return nil, fmt.Errorf("no params of iteration %d are pending to skip", body.Iterations)
+		}
+		b.skip(b.pending)
+		b.evals++
+		b.pending = nil
+	} else if body.Value != "" {
+		value, err := sumValue(body.Value)
+		if err != nil {
+			return nil, err
+		}
+		if body.Iterations == 0 {
+			if b.baseline != nil {
+				b.tell(b.baseline, value)
+			}
+		} else {
+			if b.pending == nil {
+				return nil, fmt.Errorf("no params of iteration %d are pending for the value %s",
+					body.Iterations, body.Value)
+			}
+			b.tell(b.pending, value)
+			b.evals++
+			b.pending = nil
+		}
+	}
+
+	if b.evals >= b.maxEval {
+		return b.final(), nil
+	}
+
+	b.pending = b.suggest()
+	return &models.RespPutBody{Param: b.space.format(b.pending)}, nil
+}
+
+// Delete method release the task
+func (b *Bayes) Delete() error {
+	b.finished = true
+	b.points = nil
+	b.values = nil
+	b.pending = nil
+	return nil
+}
+
+// sumValue sum the evaluations the same way as the python engine
+func sumValue(value string) (float64, error) {
+	var sum float64
+	for _, item := range strings.Split(value, ",") {
+		f, err := strconv.ParseFloat(strings.TrimSpace(item), 64)
+		if err != nil {
+			return 0, err
+		}
+		sum += f
+	}
+	return sum, nil
+}
+
+func (b *Bayes) tell(point []float64, value float64) {
+	b.points = append(b.points, point)
+	b.values = append(b.values, value)
+	b.seen[b.space.format(point)] = struct{}{}
+}
+
+// skip mark the point as seen without a value, so it is not suggested again
+// and the model does not learn from it
+func (b *Bayes) skip(point []float64) {
+	b.seen[b.space.format(point)] = struct{}{}
+}
+
+func (b *Bayes) final() *models.RespPutBody {
+	b.finished = true
+	resp := &models.RespPutBody{Finished: true}
+	if len(b.points) == 0 {
+		return resp
+	}
+	best := 0
+	for i, value := range b.values {
+		if value < b.values[best] {
+			best = i
+		}
+	}
+	resp.Param = b.space.format(b.points[best])
+	if b.selFeature {
+		resp.Rank = b.rank()
+	}
+	log.Infof("native bayes optimized result: %s", resp.Param)
+	return resp
+}
+
+func (b *Bayes) randomPoint() []float64 {
+	var point []float64
+	for i := 0; i < 100; i++ {
+		point = b.space.random(b.rng)
+		if _, ok := b.seen[b.space.format(point)]; !ok {
+			break
+		}
+	}
+	return point
+}
+
+// suggest return the next point, random points are used until enough
+// history is collected to fit the model
+func (b *Bayes) suggest() []float64 {
+	if b.evals < b.randomStarts || len(b.points) < 2 {
+		return b.randomPoint()
+	}
+
+	mean := utils.Mean(b.values)
+	sd := utils.StandardDeviation(b.values)
+	if sd == 0 {
+		sd = 1
+	}
+	x := make([][]float64, len(b.points))
+	y := make([]float64, len(b.values))
+	best := math.Inf(1)
+	for i, point := range b.points {
+		x[i] = b.space.encode(point)
+		y[i] = (b.values[i] - mean) / sd
+		best = math.Min(best, y[i])
+	}
+	gp, err := fitGaussianProcess(x, y, b.noise)
+	if err != nil {
+		log.Warnf("native bayes use random point: %v", err)
+		return b.randomPoint()
+	}
+
+	candidates := make([][]float64, 0, randomCandidates+localCenters*localCandidates)
+	for i := 0; i < randomCandidates; i++ {
+		candidates = append(candidates, b.space.random(b.rng))
+	}
+	order := make([]int, len(b.values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return b.values[order[i]] < b.values[order[j]] })
+	for i := 0; i < localCenters && i < len(order); i++ {
+		for j := 0; j < localCandidates; j++ {
+			candidates = append(candidates, b.space.neighbour(b.rng, b.points[order[i]]))
+		}
+	}
+
+	var next []float64
+	maxImprovement := math.Inf(-1)
+	for _, candidate := range candidates {
+		if _, ok := b.seen[b.space.format(candidate)]; ok {
+			continue
+		}
+		mu, std := gp.predict(b.space.encode(candidate))
+		improvement := expectedImprovement(mu, std, best, explorationXi)
+		if improvement > maxImprovement {
+			maxImprovement = improvement
+			next = candidate
+		}
+	}
+	if next == nil {
+		return b.randomPoint()
+	}
+	return next
+}
+
+// rank score the knobs by the correlation with the evaluation
+func (b *Bayes) rank() string {
+	sortedParams := make(utils.SortedPair, 0, len(b.space.dims))
+	features := make([][]float64, len(b.points))
+	for i, point := range b.points {
+		features[i] = b.space.encode(point)
+	}
+
+	col := 0
+	for _, dim := range b.space.dims {
+		width := 1
+		if dim.kind == dimCategorical {
+			width = len(dim.options)
+		}
+		var score float64
+		for c := col; c < col+width; c++ {
+			column := make([]float64, len(features))
+			for i := range features {
+				column[i] = features[i][c]
+			}
+			score = math.Max(score, math.Abs(correlation(column, b.values)))
+		}
+		col += width
+		sortedParams = append(sortedParams, utils.Pair{Name: dim.name, Score: score})
+	}
+	sort.Sort(sortedParams)
+
+	ranks := make([]string, 0, len(sortedParams))
+	for _, param := range sortedParams {
+		ranks = append(ranks, fmt.Sprintf("%s: %.3f", param.Name, param.Score))
+	}
+	return strings.Join(ranks, ", ")
+}
+
+func correlation(x []float64, y []float64) float64 {
+	sdx := utils.StandardDeviation(x)
+	sdy := utils.StandardDeviation(y)
+	if sdx == 0 || sdy == 0 {
+		return 0
+	}
+	meanX := utils.Mean(x)
+	meanY := utils.Mean(y)
+	var cov float64
+	for i := range x {
+		cov += (x[i] - meanX) * (y[i] - meanY)
+	}
+	return cov / float64(len(x)) / (sdx * sdy)
+}
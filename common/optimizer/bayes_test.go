@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gitee.com/openeuler/A-Tune/common/models"
+)
+
+func newTestBayes(t *testing.T, maxEval int32, knobs []models.Knob) *Bayes {
+	b := NewBayes().(*Bayes)
+	b.rng = rand.New(rand.NewSource(1))
+	resp, err := b.Post(&models.OptimizerPostBody{MaxEval: maxEval, RandomStarts: 5, Knobs: knobs})
+	if err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	if resp.Status != "OK" {
+		t.Fatalf("Post status = %s", resp.Status)
+	}
+	return b
+}
+
+func quadraticKnobs() []models.Knob {
+	return []models.Knob{
+		{Name: "x", Type: "continuous", Dtype: "float", Range: []float64{-2, 2}, Ref: "2"},
+		{Name: "y", Type: "discrete", Dtype: "int", Range: []float64{-2, 2}, Step: 1, Ref: "-2"},
+	}
+}
+
+// quadratic return the value of the params, the minimum is at x=0.5, y=-1
+func quadratic(t *testing.T, params string) float64 {
+	values := make(map[string]float64)
+	for _, param := range strings.Split(params, ",") {
+		kv := strings.SplitN(param, "=", 2)
+		value, err := strconv.ParseFloat(kv[1], 64)
+		if err != nil {
+			t.Fatalf("invalid params %s", params)
+		}
+		values[kv[0]] = value
+	}
+	return (values["x"]-0.5)*(values["x"]-0.5) + (values["y"]+1)*(values["y"]+1)
+}
+
+func TestBayesMinimize(t *testing.T) {
+	b := newTestBayes(t, 25, quadraticKnobs())
+	resp, err := b.Put(&models.OptimizerPutBody{Iterations: 0, Value: "10.25"})
+	if err != nil {
+		t.Fatalf("Put of the baseline failed: %v", err)
+	}
+
+	for iter := 1; !resp.Finished; iter++ {
+		if iter > 30 {
+			t.Fatalf("the task is not finished after %d iterations", iter)
+		}
+		value := strconv.FormatFloat(quadratic(t, resp.Param), 'f', -1, 64)
+		if resp, err = b.Put(&models.OptimizerPutBody{Iterations: iter, Value: value}); err != nil {
+			t.Fatalf("Put of iteration %d failed: %v", iter, err)
+		}
+	}
+	if best := quadratic(t, resp.Param); best > 0.1 {
+		t.Errorf("the best params %s = %v, want about 0", resp.Param, best)
+	}
+	if _, err := b.Put(&models.OptimizerPutBody{Iterations: 26, Value: "1"}); err == nil {
+		t.Errorf("Put of the finished task succeeded")
+	}
+}
+
+func TestBayesPutWithoutPending(t *testing.T) {
+	b := newTestBayes(t, 10, quadraticKnobs())
+	if _, err := b.Put(&models.OptimizerPutBody{Iterations: 1, Value: "1.5"}); err == nil {
+		t.Errorf("Put of the value without pending params succeeded")
+	}
+	if len(b.values) != 0 {
+		t.Errorf("the value without pending params is told to the model: %v", b.values)
+	}
+
+	resp, err := b.Put(&models.OptimizerPutBody{Iterations: 0, Value: "1.5"})
+	if err != nil {
+		t.Fatalf("Put of the baseline failed: %v", err)
+	}
+	if _, err := b.Put(&models.OptimizerPutBody{Iterations: 1, Value: "a,b"}); err == nil {
+		t.Errorf("Put of the invalid value succeeded")
+	}
+	if resp, err = b.Put(&models.OptimizerPutBody{Iterations: 1, Value: "0.5,0.25"}); err != nil {
+		t.Fatalf("Put of iteration 1 failed: %v", err)
+	}
+	if resp.Param == "" || b.evals != 1 || b.values[len(b.values)-1] != 0.75 {
+		t.Errorf("the value of iteration 1 is not told, evals %d, values %v", b.evals, b.values)
+	}
+}
+
+func TestBayesSkip(t *testing.T) {
+	b := newTestBayes(t, 2, quadraticKnobs())
+	if _, err := b.Put(&models.OptimizerPutBody{Iterations: 1, Skip: true}); err == nil {
+		t.Errorf("Put of the skip without pending params succeeded")
+	}
+	resp, err := b.Put(&models.OptimizerPutBody{Iterations: 0, Value: "10.25"})
+	if err != nil {
+		t.Fatalf("Put of the baseline failed: %v", err)
+	}
+	skipped := resp.Param
+	if resp, err = b.Put(&models.OptimizerPutBody{Iterations: 1, Skip: true}); err != nil {
+		t.Fatalf("Put of the skip failed: %v", err)
+	}
+	if len(b.values) != 1 || b.evals != 1 {
+		t.Errorf("the skipped params are told to the model, evals %d, values %v", b.evals, b.values)
+	}
+	if resp.Finished || resp.Param == "" || resp.Param == skipped {
+		t.Errorf("the params after the skip are %+v, want new params", resp)
+	}
+	if resp, err = b.Put(&models.OptimizerPutBody{Iterations: 2, Skip: true}); err != nil {
+		t.Fatalf("Put of the skip failed: %v", err)
+	}
+	if !resp.Finished {
+		t.Errorf("the task is not finished after the skips reach the max evaluations")
+	}
+}
+
+func TestBayesHistory(t *testing.T) {
+	b := NewBayes().(*Bayes)
+	body := &models.OptimizerPostBody{MaxEval: 3, Knobs: quadraticKnobs(),
+		Xref: [][]string{{"x=0.5", "y=-1"}, {"x=2", "y=2"}}, Yref: []string{"0", "11.25"}}
+	if _, err := b.Post(body); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	resp, err := b.Put(&models.OptimizerPutBody{Iterations: 2})
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if resp.Finished {
+		t.Fatalf("the task is finished with %d of 3 evaluations", b.evals)
+	}
+	resp, err = b.Put(&models.OptimizerPutBody{Iterations: 3, Value: "5"})
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if !resp.Finished || resp.Param != "x=0.5,y=-1" {
+		t.Errorf("the final response is %+v, want the history x=0.5,y=-1", resp)
+	}
+
+	body.Yref = body.Yref[:1]
+	if _, err := NewBayes().Post(body); err == nil {
+		t.Errorf("Post of the mismatched history succeeded")
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	x := []float64{1, 2, 3, 4}
+	if c := correlation(x, []float64{2, 4, 6, 8}); math.Abs(c-1) > 1e-9 {
+		t.Errorf("correlation of the linear data = %v, want 1", c)
+	}
+	if c := correlation(x, []float64{8, 6, 4, 2}); math.Abs(c+1) > 1e-9 {
+		t.Errorf("correlation of the reversed data = %v, want -1", c)
+	}
+	if c := correlation(x, []float64{5, 5, 5, 5}); c != 0 {
+		t.Errorf("correlation of the constant data = %v, want 0", c)
+	}
+}
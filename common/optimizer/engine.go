@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"gitee.com/openeuler/A-Tune/common/models"
+)
+
+// Engine : the interface of an optimizer task, either served by the
+// python engine or running in process
+type Engine interface {
+	// Post create the optimizer task
+	Post(body *models.OptimizerPostBody) (*models.RespPostBody, error)
+	// Put send the benchmark result and get the next parameters
+	Put(body *models.OptimizerPutBody) (*models.RespPutBody, error)
+	// Delete stop the optimizer task
+	Delete() error
+}
+
+// Factory used to create a new native engine
+type Factory = func() Engine
+
+// Registry used to register the native engines
+type Registry map[string]Factory
+
+// EngineTables used to get all native engine create functions
+func EngineTables() Registry {
+	return Registry{
+		BayesName: NewBayes,
+	}
+}
+
+// IsNative return true if the engine runs in process
+func IsNative(name string) bool {
+	_, ok := EngineTables()[name]
+	return ok
+}
+
+// New create the engine by name, engines which are not native
+// are served by the python engine
+func New(name string) Engine {
+	if factory, ok := EngineTables()[name]; ok {
+		return factory()
+	}
+	return &Remote{}
+}
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"fmt"
+	"math"
+)
+
+// length scales tried when fitting the gaussian process, they are
+// multiplied by the square root of the feature count
+var lengthScales = []float64{0.1, 0.2, 0.35, 0.5, 0.75, 1.0, 1.5, 2.0}
+
+// gaussianProcess is a gaussian process regression with matern 5/2 kernel
+type gaussianProcess struct {
+	x           [][]float64
+	chol        [][]float64
+	alpha       []float64
+	lengthScale float64
+	noise       float64
+}
+
+func matern52(a []float64, b []float64, lengthScale float64) float64 {
+	var dist float64
+	for i := range a {
+		dist += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	r := math.Sqrt(5*dist) / lengthScale
+	return (1 + r + r*r/3) * math.Exp(-r)
+}
+
+// cholesky return the lower triangular factor of the matrix
+func cholesky(matrix [][]float64) ([][]float64, error) {
+	n := len(matrix)
+	l := make([][]float64, n)
+	for i := range l {
+		l[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			sum := matrix[i][j]
+			for k := 0; k < j; k++ {
+				sum -= l[i][k] * l[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					return nil, fmt.Errorf("matrix is not positive definite")
+				}
+				l[i][i] = math.Sqrt(sum)
+			} else {
+				l[i][j] = sum / l[j][j]
+			}
+		}
+	}
+	return l, nil
+}
+
+// solveLower solve l * x = b
+func solveLower(l [][]float64, b []float64) []float64 {
+	x := make([]float64, len(b))
+	for i := range b {
+		sum := b[i]
+		for k := 0; k < i; k++ {
+			sum -= l[i][k] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
+
+// solveUpper solve l^T * x = b
+func solveUpper(l [][]float64, b []float64) []float64 {
+	n := len(b)
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		sum := b[i]
+		for k := i + 1; k < n; k++ {
+			sum -= l[k][i] * x[k]
+		}
+		x[i] = sum / l[i][i]
+	}
+	return x
+}
+
+func newGaussianProcess(x [][]float64, y []float64, lengthScale float64,
+	noise float64) (*gaussianProcess, float64, error) {
+	n := len(x)
+	var l [][]float64
+	var err error
+	for jitter := 1e-8; jitter < 1; jitter *= 10 {
+		k := make([][]float64, n)
+		for i := range k {
+			k[i] = make([]float64, n)
+			for j := 0; j <= i; j++ {
+				k[i][j] = matern52(x[i], x[j], lengthScale)
+				k[j][i] = k[i][j]
+			}
+			k[i][i] += noise + jitter
+		}
+		if l, err = cholesky(k); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	alpha := solveUpper(l, solveLower(l, y))
+	logLikelihood := -0.5 * float64(n) * math.Log(2*math.Pi)
+	for i := 0; i < n; i++ {
+		logLikelihood -= 0.5*y[i]*alpha[i] + math.Log(l[i][i])
+	}
+	gp := &gaussianProcess{x: x, chol: l, alpha: alpha, lengthScale: lengthScale, noise: noise}
+	return gp, logLikelihood, nil
+}
+
+// fitGaussianProcess choose the length scale with the max marginal likelihood
+func fitGaussianProcess(x [][]float64, y []float64, noise float64) (*gaussianProcess, error) {
+	var best *gaussianProcess
+	bestLikelihood := math.Inf(-1)
+	scale := math.Sqrt(float64(len(x[0])))
+	for _, lengthScale := range lengthScales {
+		gp, likelihood, err := newGaussianProcess(x, y, lengthScale*scale, noise)
+		if err != nil {
+			continue
+		}
+		if likelihood > bestLikelihood {
+			best = gp
+			bestLikelihood = likelihood
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("failed to fit the gaussian process")
+	}
+	return best, nil
+}
+
+// predict return the posterior mean and standard deviation
+func (gp *gaussianProcess) predict(x []float64) (float64, float64) {
+	k := make([]float64, len(gp.x))
+	var mean float64
+	for i, xi := range gp.x {
+		k[i] = matern52(x, xi, gp.lengthScale)
+		mean += k[i] * gp.alpha[i]
+	}
+	v := solveLower(gp.chol, k)
+	variance := 1.0
+	for _, vi := range v {
+		variance -= vi * vi
+	}
+	return mean, math.Sqrt(math.Max(variance, 1e-12))
+}
+
+// expectedImprovement of the minimization problem
+func expectedImprovement(mean float64, std float64, best float64, xi float64) float64 {
+	improve := best - mean - xi
+	z := improve / std
+	cdf := 0.5 * math.Erfc(-z/math.Sqrt2)
+	pdf := math.Exp(-0.5*z*z) / math.Sqrt(2*math.Pi)
+	return improve*cdf + std*pdf
+}
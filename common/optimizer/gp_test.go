@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCholesky(t *testing.T) {
+	matrix := [][]float64{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}}
+	want := [][]float64{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}}
+	l, err := cholesky(matrix)
+	if err != nil {
+		t.Fatalf("cholesky failed: %v", err)
+	}
+	for i := range want {
+		for j := range want[i] {
+			if math.Abs(l[i][j]-want[i][j]) > 1e-9 {
+				t.Errorf("l[%d][%d] = %v, want %v", i, j, l[i][j], want[i][j])
+			}
+		}
+	}
+
+	b := []float64{1, 2, 3}
+	x := solveUpper(l, solveLower(l, b))
+	for i := range matrix {
+		var sum float64
+		for j := range matrix[i] {
+			sum += matrix[i][j] * x[j]
+		}
+		if math.Abs(sum-b[i]) > 1e-9 {
+			t.Errorf("row %d of matrix * x = %v, want %v", i, sum, b[i])
+		}
+	}
+
+	if _, err := cholesky([][]float64{{1, 2}, {2, 1}}); err == nil {
+		t.Errorf("cholesky of the indefinite matrix succeeded")
+	}
+}
+
+func TestGaussianProcessPredict(t *testing.T) {
+	x := [][]float64{{0}, {0.25}, {0.5}, {0.75}, {1}}
+	y := make([]float64, len(x))
+	for i := range x {
+		y[i] = math.Sin(2 * math.Pi * x[i][0])
+	}
+	gp, err := fitGaussianProcess(x, y, 1e-6)
+	if err != nil {
+		t.Fatalf("fit failed: %v", err)
+	}
+
+	for i := range x {
+		mean, std := gp.predict(x[i])
+		if math.Abs(mean-y[i]) > 1e-3 {
+			t.Errorf("mean at the sample %v = %v, want %v", x[i], mean, y[i])
+		}
+		if std > 1e-2 {
+			t.Errorf("std at the sample %v = %v, want about 0", x[i], std)
+		}
+	}
+
+	_, nearStd := gp.predict([]float64{0.6})
+	_, farStd := gp.predict([]float64{3})
+	if nearStd >= farStd {
+		t.Errorf("std near the samples %v is not less than far from them %v", nearStd, farStd)
+	}
+	if farStd > 1+1e-9 {
+		t.Errorf("std far from the samples %v is above the prior 1", farStd)
+	}
+}
+
+func TestExpectedImprovement(t *testing.T) {
+	tests := []struct {
+		name string
+		mean float64
+		std  float64
+		best float64
+		want float64
+	}{
+		{"no uncertainty and better", -1, 1e-12, 0, 1},
+		{"no uncertainty and worse", 1, 1e-12, 0, 0},
+		{"equal to the best", 0, 1, 0, 1 / math.Sqrt(2*math.Pi)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expectedImprovement(tt.mean, tt.std, tt.best, 0)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("expectedImprovement = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if expectedImprovement(0, 1, 0, 0) <= expectedImprovement(0, 0.5, 0, 0) {
+		t.Errorf("expected improvement does not grow with the uncertainty")
+	}
+	if expectedImprovement(-1, 1, 0, 0) <= expectedImprovement(1, 1, 0, 0) {
+		t.Errorf("expected improvement does not grow with the lower mean")
+	}
+	if expectedImprovement(0, 1, 0, 0.5) >= expectedImprovement(0, 1, 0, 0) {
+		t.Errorf("expected improvement does not shrink with the exploration xi")
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"fmt"
+
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/http"
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+)
+
+// Remote : the optimizer task served by the python engine
+type Remote struct {
+	URL string
+}
+
+// Post method create the task in the python engine
+func (r *Remote) Post(body *models.OptimizerPostBody) (*models.RespPostBody, error) {
+	respPostIns, err := body.Post()
+	if err != nil {
+		return nil, err
+	}
+	if respPostIns.Status == "OK" {
+		r.URL = fmt.Sprintf("%s/%s", config.GetURL(config.OptimizerURI), respPostIns.TaskID)
+		log.Infof("optimizer put url is: %s", r.URL)
+	}
+	return respPostIns, nil
+}
+
+// Put method send the benchmark result to the python engine
+func (r *Remote) Put(body *models.OptimizerPutBody) (*models.RespPutBody, error) {
+	if r.URL == "" {
+		return nil, fmt.Errorf("optimizer task is not created")
+	}
+	if body.Skip {
+		return nil, fmt.Errorf("the python engine can not skip the params of iteration %d", body.Iterations)
+	}
+	return body.Put(r.URL)
+}
+
+// Delete method delete the task in the python engine
+func (r *Remote) Delete() error {
+	if r.URL == "" {
+		return nil
+	}
+	resp, err := http.Delete(r.URL)
+	if err != nil {
+		log.Error("delete task failed:", err)
+		return err
+	}
+	resp.Body.Close()
+	log.Infof("delete task %s success!", r.URL)
+	r.URL = ""
+	return nil
+}
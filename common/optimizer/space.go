@@ -0,0 +1,415 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/models"
+)
+
+const (
+	dimContinuous = iota
+	dimDiscrete
+	dimCategorical
+)
+
+// dimension is the search range of one knob, a point holds the value of
+// a continuous dimension and the index of a discrete or categorical one,
+// the dimension is active only when the parent has the value of condition
+type dimension struct {
+	name       string
+	kind       int
+	isInt      bool
+	lower      float64
+	upper      float64
+	values     []float64
+	options    []string
+	parentName string
+	parent     *dimension
+	condition  []string
+}
+
+type space struct {
+	dims []*dimension
+}
+
+func roundFloat(value float64) float64 {
+	return math.Round(value*1e6) / 1e6
+}
+
+// newSpace build the search space the same way as the python engine
+func newSpace(knobs []models.Knob) (*space, error) {
+	s := &space{}
+	for _, knob := range knobs {
+		dim := &dimension{name: knob.Name}
+		switch knob.Type {
+		case "continuous":
+			if len(knob.Range) != 2 {
+				return nil, fmt.Errorf("the item of the scope value of %s must be 2", knob.Name)
+			}
+			dim.kind = dimContinuous
+			dim.lower, dim.upper = knob.Range[0], knob.Range[1]
+			switch knob.Dtype {
+			case "int":
+				dim.isInt = true
+				dim.lower, dim.upper = math.Trunc(dim.lower), math.Trunc(dim.upper)
+			case "float":
+			default:
+				return nil, fmt.Errorf("the dtype of %s is not supported", knob.Name)
+			}
+			if dim.lower > dim.upper {
+				return nil, fmt.Errorf("the scope value of %s is not correct", knob.Name)
+			}
+		case "discrete":
+			if err := dim.buildDiscrete(knob); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("the type of %s is not supported", knob.Name)
+		}
+		dim.parentName = knob.Parent
+		dim.condition = knob.Condition
+		s.dims = append(s.dims, dim)
+	}
+	if err := s.linkParents(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// linkParents link the conditional dimensions to their parents, the
+// dimension whose parent is not in the space is never active
+func (s *space) linkParents() error {
+	dims := make(map[string]*dimension, len(s.dims))
+	for _, dim := range s.dims {
+		dims[dim.name] = dim
+	}
+	for _, dim := range s.dims {
+		if dim.parentName != "" {
+			dim.parent = dims[dim.parentName]
+		}
+	}
+	for _, dim := range s.dims {
+		parent := dim.parent
+		for depth := 0; parent != nil && depth <= len(s.dims); depth++ {
+			if parent == dim {
+				return fmt.Errorf("the conditions of %s are circular", dim.name)
+			}
+			parent = parent.parent
+		}
+	}
+	return nil
+}
+
+func (d *dimension) buildDiscrete(knob models.Knob) error {
+	if knob.Dtype == "string" {
+		if len(knob.Options) == 0 {
+			return fmt.Errorf("the options of %s is empty", knob.Name)
+		}
+		d.kind = dimCategorical
+		d.options = append(d.options, knob.Options...)
+		if knob.Ref != "" && d.optionIndex(knob.Ref) < 0 {
+			d.options = append(d.options, knob.Ref)
+		}
+		return nil
+	}
+
+	var step float64
+	switch knob.Dtype {
+	case "int":
+		d.isInt = true
+		step = math.Max(1, knob.Step)
+	case "float":
+		step = knob.Step
+		if step <= 0 {
+			step = 0.1
+		}
+	default:
+		return fmt.Errorf("the dtype of %s is not supported", knob.Name)
+	}
+
+	d.kind = dimDiscrete
+	set := make(map[float64]struct{})
+	for _, item := range knob.Items {
+		set[item] = struct{}{}
+	}
+	for i := 0; i+1 < len(knob.Range); i += 2 {
+		lower, upper := knob.Range[i], knob.Range[i+1]
+		if d.isInt {
+			upper++
+		}
+		for n := 0; lower+float64(n)*step < upper; n++ {
+			set[roundFloat(lower+float64(n)*step)] = struct{}{}
+		}
+	}
+	if ref, err := strconv.ParseFloat(strings.TrimSpace(knob.Ref), 64); err == nil {
+		set[ref] = struct{}{}
+	}
+	if len(set) == 0 {
+		return fmt.Errorf("the items of %s is empty", knob.Name)
+	}
+	for value := range set {
+		d.values = append(d.values, value)
+	}
+	sort.Float64s(d.values)
+	return nil
+}
+
+func (d *dimension) optionIndex(option string) int {
+	for i, item := range d.options {
+		if item == option {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *dimension) size() int {
+	if d.kind == dimCategorical {
+		return len(d.options)
+	}
+	return len(d.values)
+}
+
+// parse convert the string value of the knob to the point value
+func (d *dimension) parse(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	if d.kind == dimCategorical {
+		index := d.optionIndex(value)
+		if index < 0 {
+			return 0, fmt.Errorf("the value %s of %s is not in the options", value, d.name)
+		}
+		return float64(index), nil
+	}
+
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("the value %s of %s is not a number", value, d.name)
+	}
+	if d.kind == dimContinuous {
+		return math.Min(math.Max(f, d.lower), d.upper), nil
+	}
+	index := sort.SearchFloat64s(d.values, f)
+	if index == len(d.values) || index > 0 && f-d.values[index-1] < d.values[index]-f {
+		index--
+	}
+	return float64(index), nil
+}
+
+// format convert the point value to the string value of the knob
+func (d *dimension) format(value float64) string {
+	switch d.kind {
+	case dimCategorical:
+		return d.options[int(value)]
+	case dimDiscrete:
+		value = d.values[int(value)]
+	}
+	if d.isInt {
+		return strconv.FormatInt(int64(math.Round(value)), 10)
+	}
+	return strconv.FormatFloat(roundFloat(value), 'f', -1, 64)
+}
+
+// fixed return the value of the dimension when it is inactive, so that the
+// points which differ only in the inactive dimensions are the same
+func (d *dimension) fixed() float64 {
+	if d.kind == dimContinuous {
+		return d.lower
+	}
+	return 0
+}
+
+// matches return true if the value of the parent is in the condition
+func (d *dimension) matches(parent *dimension, value float64) bool {
+	formatted := parent.format(value)
+	for _, condition := range d.condition {
+		if condition == formatted {
+			return true
+		}
+		if number, err := strconv.ParseFloat(strings.TrimSpace(condition), 64); err == nil &&
+			parent.kind != dimCategorical && math.Abs(number-roundFloat(value)) < 1e-6 {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dimension) random(rng *rand.Rand) float64 {
+	switch {
+	case d.kind != dimContinuous:
+		return float64(rng.Intn(d.size()))
+	case d.isInt:
+		return d.lower + float64(rng.Int63n(int64(d.upper-d.lower)+1))
+	default:
+		return d.lower + rng.Float64()*(d.upper-d.lower)
+	}
+}
+
+// neighbour move the value a little, categorical value is resampled
+func (d *dimension) neighbour(rng *rand.Rand, value float64) float64 {
+	switch d.kind {
+	case dimCategorical:
+		return d.random(rng)
+	case dimDiscrete:
+		index := int(value) + rng.Intn(5) - 2
+		if index < 0 {
+			index = 0
+		}
+		if index >= d.size() {
+			index = d.size() - 1
+		}
+		return float64(index)
+	}
+	value += rng.NormFloat64() * 0.1 * (d.upper - d.lower)
+	value = math.Min(math.Max(value, d.lower), d.upper)
+	if d.isInt {
+		value = math.Round(value)
+	}
+	return value
+}
+
+// encode append the features of the value scaled to [0, 1],
+// categorical value is one-hot encoded
+func (d *dimension) encode(features []float64, value float64) []float64 {
+	switch d.kind {
+	case dimCategorical:
+		for i := range d.options {
+			if i == int(value) {
+				features = append(features, 1)
+			} else {
+				features = append(features, 0)
+			}
+		}
+		return features
+	case dimDiscrete:
+		if d.size() == 1 {
+			return append(features, 0)
+		}
+		return append(features, value/float64(d.size()-1))
+	}
+	if d.upper == d.lower {
+		return append(features, 0)
+	}
+	return append(features, (value-d.lower)/(d.upper-d.lower))
+}
+
+// active return whether each dimension is active at the point
+func (s *space) active(point []float64) []bool {
+	index := make(map[*dimension]int, len(s.dims))
+	for i, dim := range s.dims {
+		index[dim] = i
+	}
+	active := make([]bool, len(s.dims))
+	for i, dim := range s.dims {
+		active[i] = true
+		for child := dim; child.parentName != ""; child = child.parent {
+			if child.parent == nil || !child.matches(child.parent, point[index[child.parent]]) {
+				active[i] = false
+				break
+			}
+		}
+	}
+	return active
+}
+
+// canonical set the inactive dimensions of the point to the fixed values
+func (s *space) canonical(point []float64) []float64 {
+	for i, active := range s.active(point) {
+		if !active {
+			point[i] = s.dims[i].fixed()
+		}
+	}
+	return point
+}
+
+func (s *space) random(rng *rand.Rand) []float64 {
+	point := make([]float64, len(s.dims))
+	for i, dim := range s.dims {
+		point[i] = dim.random(rng)
+	}
+	return s.canonical(point)
+}
+
+func (s *space) neighbour(rng *rand.Rand, point []float64) []float64 {
+	next := make([]float64, len(point))
+	copy(next, point)
+	moved := false
+	for i, dim := range s.dims {
+		if rng.Intn(2) == 0 {
+			next[i] = dim.neighbour(rng, next[i])
+			moved = true
+		}
+	}
+	if !moved && len(s.dims) > 0 {
+		i := rng.Intn(len(s.dims))
+		next[i] = s.dims[i].neighbour(rng, next[i])
+	}
+	return s.canonical(next)
+}
+
+func (s *space) encode(point []float64) []float64 {
+	features := make([]float64, 0, len(point))
+	for i, dim := range s.dims {
+		features = dim.encode(features, point[i])
+	}
+	return features
+}
+
+func (s *space) format(point []float64) string {
+	params := make([]string, 0, len(point))
+	active := s.active(point)
+	for i, dim := range s.dims {
+		if !active[i] {
+			params = append(params, dim.name+"="+models.InactiveValue)
+			continue
+		}
+		params = append(params, dim.name+"="+dim.format(point[i]))
+	}
+	return strings.Join(params, ",")
+}
+
+// parse convert the name=value list to the point
+func (s *space) parse(params []string) ([]float64, error) {
+	kvs := make(map[string]string)
+	for _, param := range params {
+		kv := strings.SplitN(param, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("the param format of %s is not correct", param)
+		}
+		kvs[strings.TrimSpace(kv[0])] = kv[1]
+	}
+
+	point := make([]float64, len(s.dims))
+	for i, dim := range s.dims {
+		value, ok := kvs[dim.name]
+		if !ok {
+			return nil, fmt.Errorf("the param %s is not in the x0 ref", dim.name)
+		}
+		if strings.TrimSpace(value) == models.InactiveValue {
+			point[i] = dim.fixed()
+			continue
+		}
+		var err error
+		if point[i], err = dim.parse(value); err != nil {
+			return nil, err
+		}
+	}
+	return s.canonical(point), nil
+}
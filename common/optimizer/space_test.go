@@ -0,0 +1,175 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package optimizer
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"gitee.com/openeuler/A-Tune/common/models"
+)
+
+func testKnobs() []models.Knob {
+	return []models.Knob{
+		{Name: "engine", Type: "discrete", Dtype: "string", Options: []string{"innodb", "myisam"}, Ref: "innodb"},
+		{Name: "pool_size", Type: "discrete", Dtype: "int", Range: []float64{128, 1024}, Step: 128, Ref: "128",
+			Parent: "engine", Condition: []string{"innodb"}},
+		{Name: "pool_instances", Type: "continuous", Dtype: "int", Range: []float64{1, 8}, Ref: "1",
+			Parent: "pool_size", Condition: []string{"1024"}},
+		{Name: "ratio", Type: "continuous", Dtype: "float", Range: []float64{0.1, 0.9}, Ref: "0.5"},
+		{Name: "threads", Type: "discrete", Dtype: "int", Items: []float64{3, 7}, Range: []float64{16, 32},
+			Step: 16, Ref: "16"},
+	}
+}
+
+func TestNewSpace(t *testing.T) {
+	s, err := newSpace(testKnobs())
+	if err != nil {
+		t.Fatalf("newSpace failed: %v", err)
+	}
+	want := map[string]int{"engine": 2, "pool_size": 8, "threads": 4}
+	for _, dim := range s.dims {
+		if size, ok := want[dim.name]; ok && dim.size() != size {
+			t.Errorf("size of %s = %d, want %d", dim.name, dim.size(), size)
+		}
+	}
+	if s.dims[1].parent != s.dims[0] || s.dims[2].parent != s.dims[1] {
+		t.Errorf("the parents of the conditional dimensions are not linked")
+	}
+
+	tests := []struct {
+		name string
+		knob models.Knob
+	}{
+		{"unknown type", models.Knob{Name: "a", Type: "ordinal", Dtype: "int"}},
+		{"continuous without range", models.Knob{Name: "a", Type: "continuous", Dtype: "int"}},
+		{"reversed range", models.Knob{Name: "a", Type: "continuous", Dtype: "int", Range: []float64{8, 1}}},
+		{"unknown dtype", models.Knob{Name: "a", Type: "continuous", Dtype: "bool", Range: []float64{0, 1}}},
+		{"empty options", models.Knob{Name: "a", Type: "discrete", Dtype: "string"}},
+		{"empty items", models.Knob{Name: "a", Type: "discrete", Dtype: "int", Ref: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := newSpace([]models.Knob{tt.knob}); err == nil {
+				t.Errorf("newSpace succeeded, want an error")
+			}
+		})
+	}
+
+	circular := []models.Knob{
+		{Name: "a", Type: "discrete", Dtype: "string", Options: []string{"on", "off"}, Parent: "b", Condition: []string{"on"}},
+		{Name: "b", Type: "discrete", Dtype: "string", Options: []string{"on", "off"}, Parent: "a", Condition: []string{"on"}},
+	}
+	if _, err := newSpace(circular); err == nil {
+		t.Errorf("newSpace of the circular conditions succeeded")
+	}
+}
+
+func TestSpaceParseFormat(t *testing.T) {
+	s, err := newSpace(testKnobs())
+	if err != nil {
+		t.Fatalf("newSpace failed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		params string
+		want   string
+	}{
+		{"all active", "engine=innodb,pool_size=1024,pool_instances=4,ratio=0.35,threads=7",
+			"engine=innodb,pool_size=1024,pool_instances=4,ratio=0.35,threads=7"},
+		{"grandchild inactive", "engine=innodb,pool_size=512,pool_instances=4,ratio=0.9,threads=32",
+			"engine=innodb,pool_size=512,pool_instances=inactive,ratio=0.9,threads=32"},
+		{"children inactive", "engine=myisam,pool_size=1024,pool_instances=4,ratio=0.1,threads=16",
+			"engine=myisam,pool_size=inactive,pool_instances=inactive,ratio=0.1,threads=16"},
+		{"inactive values", "engine=myisam,pool_size=inactive,pool_instances=inactive,ratio=0.5,threads=3",
+			"engine=myisam,pool_size=inactive,pool_instances=inactive,ratio=0.5,threads=3"},
+		{"nearest discrete and clipped", "engine=innodb,pool_size=1000,pool_instances=20,ratio=2,threads=8",
+			"engine=innodb,pool_size=1024,pool_instances=8,ratio=0.9,threads=7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			point, err := s.parse(strings.Split(tt.params, ","))
+			if err != nil {
+				t.Fatalf("parse failed: %v", err)
+			}
+			got := s.format(point)
+			if got != tt.want {
+				t.Errorf("format(parse(%s)) = %s, want %s", tt.params, got, tt.want)
+			}
+			again, err := s.parse(strings.Split(got, ","))
+			if err != nil {
+				t.Fatalf("parse of the formatted params failed: %v", err)
+			}
+			if s.format(again) != got {
+				t.Errorf("the round trip of %s changed to %s", got, s.format(again))
+			}
+		})
+	}
+
+	invalid := []string{
+		"engine=innodb,pool_size=1024,pool_instances=4,ratio=0.5",
+		"engine=aria,pool_size=1024,pool_instances=4,ratio=0.5,threads=16",
+		"engine=innodb,pool_size=big,pool_instances=4,ratio=0.5,threads=16",
+		"engine=innodb,pool_size",
+	}
+	for _, params := range invalid {
+		if _, err := s.parse(strings.Split(params, ",")); err == nil {
+			t.Errorf("parse of %s succeeded, want an error", params)
+		}
+	}
+}
+
+func TestSpaceCanonical(t *testing.T) {
+	s, err := newSpace(testKnobs())
+	if err != nil {
+		t.Fatalf("newSpace failed: %v", err)
+	}
+
+	// myisam with different values of the inactive children is the same point
+	a, _ := s.parse(strings.Split("engine=myisam,pool_size=256,pool_instances=2,ratio=0.5,threads=16", ","))
+	b, _ := s.parse(strings.Split("engine=myisam,pool_size=1024,pool_instances=7,ratio=0.5,threads=16", ","))
+	for i := range a {
+		if a[i] != b[i] {
+			t.Errorf("dimension %s of the canonical points differs: %v and %v", s.dims[i].name, a[i], b[i])
+		}
+	}
+	featuresA, featuresB := s.encode(a), s.encode(b)
+	for i := range featuresA {
+		if featuresA[i] != featuresB[i] {
+			t.Errorf("feature %d of the canonical points differs: %v and %v", i, featuresA[i], featuresB[i])
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		point := s.random(rng)
+		if i%2 == 1 {
+			point = s.neighbour(rng, point)
+		}
+		active := s.active(point)
+		for j, dim := range s.dims {
+			if !active[j] && point[j] != dim.fixed() {
+				t.Fatalf("inactive %s of point %s is %v, want %v", dim.name, s.format(point), point[j], dim.fixed())
+			}
+		}
+		features := s.encode(point)
+		for _, feature := range features {
+			if feature < 0 || feature > 1 {
+				t.Fatalf("feature %v of point %s is out of [0, 1]", feature, s.format(point))
+			}
+		}
+	}
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package project
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+)
+
+// Condition method return the parent knob and the value of the parent which
+// activates the knob for the optimizer, the knob rely_on the value of one
+// parent, the parent is empty if the knob does not rely on any knob, or
+// relies on more than one knob or value, such knob is searched as always
+// active, and it is only set when all its rely_on relations match
+func (o *YamlPrjObj) Condition() (string, []string) {
+	parent, value := "", ""
+	for _, relation := range o.Relations {
+		if relation.Type != RELY_ON {
+			continue
+		}
+		if parent != "" && (relation.Target != parent || relation.Value != value) {
+			return "", nil
+		}
+		parent, value = relation.Target, relation.Value
+	}
+	if parent == "" {
+		return "", nil
+	}
+	return parent, []string{value}
+}
+
+// Conditions method return the parent and the values of the condition of
+// each knob sent to the optimizer, the conditions which are circular are
+// not sent, these knobs are searched as always active
+func (y *YamlPrjSvr) Conditions() map[string][]string {
+	parents := make(map[string]string)
+	conditions := make(map[string][]string)
+	for _, obj := range y.Object {
+		parent, values := obj.Condition()
+		if parent != "" {
+			parents[obj.Name] = parent
+			conditions[obj.Name] = append([]string{parent}, values...)
+		}
+	}
+	for name := range y.circularConditions(parents) {
+		log.Warnf("the conditions of knob %s are circular, it is searched as always active", name)
+		delete(conditions, name)
+	}
+	return conditions
+}
+
+// CheckConditions method check the conditions of the knobs, the parents of
+// the conditions must not rely on each other
+func (y *YamlPrjSvr) CheckConditions() error {
+	parents := make(map[string]string)
+	for _, obj := range y.Object {
+		if parent, _ := obj.Condition(); parent != "" {
+			parents[obj.Name] = parent
+		}
+	}
+	circular := make([]string, 0)
+	for name := range y.circularConditions(parents) {
+		circular = append(circular, name)
+	}
+	if len(circular) > 0 {
+		sort.Strings(circular)
+		return fmt.Errorf("the conditions of knobs %s are circular, they are searched as always active",
+			strings.Join(circular, ","))
+	}
+	return nil
+}
+
+func (y *YamlPrjSvr) circularConditions(parents map[string]string) map[string]bool {
+	circular := make(map[string]bool)
+	for name := range parents {
+		next := name
+		for depth := 0; depth <= len(parents); depth++ {
+			if next = parents[next]; next == "" {
+				break
+			}
+			if next == name {
+				circular[name] = true
+				break
+			}
+		}
+	}
+	return circular
+}
+
+// InactiveKnobs method return the knobs which are inactive with the params,
+// the knob is inactive if the value of the target of any rely_on relation
+// does not match, the target which is inactive has the value inactive
+func (y *YamlPrjSvr) InactiveKnobs(paraMap map[string]string) map[string]bool {
+	inactive := make(map[string]bool)
+	for _, obj := range y.Object {
+		for _, relation := range obj.Relations {
+			if relation.Type == RELY_ON && strings.TrimSpace(paraMap[relation.Target]) != relation.Value {
+				inactive[obj.Name] = true
+				break
+			}
+		}
+	}
+	return inactive
+}
+
+// MarkInactive method replace the values of the inactive knobs in the params
+// with inactive, the other params are kept in order
+func (y *YamlPrjSvr) MarkInactive(params string) string {
+	if params == "" {
+		return params
+	}
+	paraMap := make(map[string]string)
+	paraSlice := strings.Split(params, ",")
+	for _, para := range paraSlice {
+		kvs := strings.SplitN(para, "=", 2)
+		if len(kvs) == 2 {
+			paraMap[strings.TrimSpace(kvs[0])] = strings.TrimSpace(kvs[1])
+		}
+	}
+
+	inactive := y.InactiveKnobs(paraMap)
+	if len(inactive) == 0 {
+		return params
+	}
+	for i, para := range paraSlice {
+		kvs := strings.SplitN(para, "=", 2)
+		if len(kvs) == 2 && inactive[strings.TrimSpace(kvs[0])] {
+			paraSlice[i] = kvs[0] + "=" + models.InactiveValue
+		}
+	}
+	return strings.Join(paraSlice, ",")
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package project
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// the actions on the knobs of the remote nodes in the cluster
+const (
+	KnobSet     = "set"
+	KnobGet     = "get"
+	KnobReload  = "reload"
+	KnobRestart = "restart"
+)
+
+// safeValue : the value of the knob sent to the remote node must not contain
+// the shell metacharacters, it is substituted into the set script
+var safeValue = regexp.MustCompile(`^[\w.:/@%+=\-\[\] \t]*$`)
+
+// KnobValue : the action on the knob of the project on the remote node, the
+// node resolves it to the scripts of its own project yaml, so that no script
+// is sent between the nodes
+type KnobValue struct {
+	Action  string `json:"action"`
+	Project string `json:"project"`
+	Name    string `json:"name,omitempty"`
+	Group   string `json:"group,omitempty"`
+	Value   string `json:"value,omitempty"`
+	Depend  string `json:"depend,omitempty"`
+}
+
+// NodeKnobs : the knobs of the remote nodes in the cluster, keyed by the address
+type NodeKnobs map[string][]*KnobValue
+
+// NodeScripts method resolve the action on the knob to the scripts of the
+// project, the value and the depend name of the set action are checked
+func (y *YamlPrjSvr) NodeScripts(knob *KnobValue) ([]string, error) {
+	if knob.Project != y.Project {
+		return nil, fmt.Errorf("knob %s is not in project %s", knob.Name, y.Project)
+	}
+	if knob.Action == KnobRestart {
+		return []string{y.Stopworkload, y.Startworkload}, nil
+	}
+
+	name := knob.Name
+	if knob.Group != "" {
+		name = strings.TrimSuffix(name, "-"+knob.Group)
+	}
+	var obj *YamlPrjObj
+	for _, item := range y.Object {
+		if item.Name == name && !item.Info.Skip {
+			obj = item
+			break
+		}
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("knob %s is not found in project %s", knob.Name, y.Project)
+	}
+
+	switch knob.Action {
+	case KnobGet:
+		return []string{obj.Info.GetScript}, nil
+	case KnobReload:
+		if obj.Info.Reload == "" {
+			return nil, fmt.Errorf("knob %s of project %s has no reload script", knob.Name, y.Project)
+		}
+		return []string{obj.Info.Reload}, nil
+	case KnobSet:
+		if !safeValue.MatchString(knob.Value) {
+			return nil, fmt.Errorf("invalid value %q of knob %s", knob.Value, knob.Name)
+		}
+		depends := make([]string, 0)
+		for _, relation := range obj.Relations {
+			if relation.Type == DEPEND_ON {
+				depends = append(depends, relation.SrcName)
+			}
+		}
+		if knob.Depend != "" && !utils.CheckValueInSlice(knob.Depend, depends) {
+			return nil, fmt.Errorf("invalid depend name %q of knob %s", knob.Depend, knob.Name)
+		}
+		return []string{setScript(obj.Info.SetScript, knob.Value, knob.Depend)}, nil
+	default:
+		return nil, fmt.Errorf("unknown action %s of knob %s", knob.Action, knob.Name)
+	}
+}
+
+// setScript return the set script whose $value and $name are replaced
+func setScript(script string, value string, name string) string {
+	var newScript string
+	if len(strings.Fields(value)) > 1 {
+		newScript = strings.Replace(script, "$value", "\""+value+"\"", -1)
+	} else {
+		newScript = strings.Replace(script, "$value", value, -1)
+	}
+	return strings.Replace(newScript, "$name", name, -1)
+}
@@ -16,15 +16,18 @@ package project
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"math"
 	"os/exec"
-	"regexp"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	PB "gitee.com/openeuler/A-Tune/api/profile"
 	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/constraint"
+	"gitee.com/openeuler/A-Tune/common/extractor"
 	"gitee.com/openeuler/A-Tune/common/log"
 	"gitee.com/openeuler/A-Tune/common/utils"
 )
@@ -37,6 +40,40 @@ const (
 	MULTIPLE  = "multiple"
 )
 
+// the policy when the benchmark of the iteration failed
+const (
+	FailureAbort    = "abort"
+	FailurePenalize = "penalize"
+	FailureSkip     = "skip"
+)
+
+// the objective mode of the evaluations, pareto keeps the non-dominated
+// iterations and the weights are only used to show the progress. The
+// optimizer of pareto still minimizes one fixed scalarization, the sum of
+// the objectives with equal weights, because the evaluations already sent
+// can not be scalarized again, so the search concentrates on the trade-off
+// of equal weights and the front is not searched evenly
+const (
+	ObjectiveWeighted = "weighted"
+	ObjectivePareto   = "pareto"
+)
+
+// the role of the evaluation, the constraint evaluation is not weighted and
+// the iteration which violates its bound is infeasible
+const (
+	RoleObjective  = "objective"
+	RoleConstraint = "constraint"
+
+	boundVar = "value"
+)
+
+// the status of the failed iteration
+const (
+	IterationPenalized  = "penalized"
+	IterationSkipped    = "skipped"
+	IterationInfeasible = "infeasible"
+)
+
 // Evaluate :store the evaluate object
 type Evaluate struct {
 	Name string   `yaml:"name"`
@@ -45,10 +82,43 @@ type Evaluate struct {
 
 // EvalInfo :store the evaluation object
 type EvalInfo struct {
-	Get       string  `yaml:"get"`
-	Type      string  `yaml:"type"`
-	Weight    int64   `yaml:"weight"`
-	Threshold float64 `yaml:"threshold"`
+	Get       string   `yaml:"get"`
+	Type      string   `yaml:"type"`
+	Weight    int64    `yaml:"weight"`
+	Threshold float64  `yaml:"threshold"`
+	Aggregate string   `yaml:"aggregate"`
+	Penalty   *float64 `yaml:"penalty"`
+	Role      string   `yaml:"role"`
+	Bound     string   `yaml:"bound"`
+	// Extract is the built-in extractor of the benchmark output, which is
+	// used instead of the get script if it is set
+	Extract *extractor.Spec `yaml:"extract"`
+}
+
+// IsConstraint method return true if the evaluation is a constraint, which
+// is not optimized but must satisfy the bound
+func (e *EvalInfo) IsConstraint() bool {
+	return e.Role == RoleConstraint
+}
+
+// CheckBound method check the bound of the constraint evaluation, such as "< 20"
+func (e *EvalInfo) CheckBound() error {
+	_, err := e.Satisfied(0)
+	return err
+}
+
+// Satisfied method return true if the value of the evaluation satisfies the bound
+func (e *EvalInfo) Satisfied(value float64) (bool, error) {
+	bound, err := constraint.Parse(boundVar + " " + e.Bound)
+	if err != nil {
+		return false, err
+	}
+	for _, name := range bound.Vars() {
+		if name != boundVar {
+			return false, fmt.Errorf("unknown variable %s in bound %q", name, e.Bound)
+		}
+	}
+	return bound.Check(nil, map[string]float64{boundVar: value})
 }
 
 // YamlPrjCli :store the client yaml project
@@ -57,6 +127,7 @@ type YamlPrjCli struct {
 	Iterations          int32      `yaml:"iterations"`
 	RandomStarts        int32      `yaml:"random_starts"`
 	Benchmark           string     `yaml:"benchmark"`
+	BenchmarkTarget     string     `yaml:"benchmark_target"`
 	Engine              string     `yaml:"engine"`
 	FeatureFilterEngine string     `yaml:"feature_filter_engine"`
 	FeatureFilterCycle  int32      `yaml:"feature_filter_cycle"`
@@ -65,56 +136,97 @@ type YamlPrjCli struct {
 	FeatureSelector     string     `yaml:"feature_selector"`
 	SplitCount          int32      `yaml:"split_count"`
 	EvalFluctuation     float64    `yaml:"eval_fluctuation"`
+	Repeat              int32      `yaml:"repeat"`
+	BenchmarkTimeout    string     `yaml:"benchmark_timeout"`
+	BenchmarkRetries    int32      `yaml:"benchmark_retries"`
+	OnFailure           string     `yaml:"on_failure"`
+	MaxDuration         string     `yaml:"max_duration"`
+	TargetImprovement   float64    `yaml:"target_improvement"`
+	PlateauIters        int32      `yaml:"plateau_iters"`
+	PlateauImprovement  float64    `yaml:"plateau_improvement"`
+	ObjectiveMode       string     `yaml:"objective_mode"`
 	Evaluations         []Evaluate `yaml:"evaluations"`
 	StartsTime          time.Time  `yaml:"-"`
 	TotalTime           int64      `yaml:"-"`
 	EvalMin             float64    `yaml:"-"`
 	EvalMinArray        []float64  `yaml:"-"`
 	EvalBaseArray       []float64  `yaml:"-"`
+	EvalWorstArray      []float64  `yaml:"-"`
 	EvalCurrent         float64    `yaml:"-"`
 	EvalCurrentArray    []float64  `yaml:"-"`
+	EvalCVArray         []float64  `yaml:"-"`
+	Violations          []string   `yaml:"-"`
 	StartIters          int32      `yaml:"-"`
 	TotalIters          int32      `yaml:"-"`
 	Params              string     `yaml:"-"`
 	FeatureFilter       bool       `yaml:"-"`
 	Baseline            bool       `yaml:"-"`
+	Executor            Executor   `yaml:"-"`
+}
+
+// Executor : the executor of the benchmark and evaluation scripts, such as
+// the atuned running in agent mode on the load-generator host
+type Executor interface {
+	Exec(script string, timeout time.Duration) ([]byte, error)
 }
 
 // YamlPrjSvr :store the server yaml project
 type YamlPrjSvr struct {
-	Project       string        `yaml:"project"`
-	Object        []*YamlPrjObj `yaml:"object"`
-	Maxiterations int32         `yaml:"maxiterations"`
-	Startworkload string        `yaml:"startworkload"`
-	Stopworkload  string        `yaml:"stopworkload"`
+	Project          string        `yaml:"project"`
+	Object           []*YamlPrjObj `yaml:"object"`
+	Maxiterations    int32         `yaml:"maxiterations"`
+	Startworkload    string        `yaml:"startworkload"`
+	Stopworkload     string        `yaml:"stopworkload"`
+	DisconnectPolicy string        `yaml:"disconnect_policy"`
+	Constraints      []string      `yaml:"constraints"`
+	applied          map[string]string
+	previous         map[string]string
+	requested        map[string]string
+	changed          []string
 }
 
 // YamlObj :yaml Object
 type YamlObj struct {
-	Name        string    `yaml:"name"`
-	Desc        string    `yaml:"desc"`
-	GetScript   string    `yaml:"get"`
-	SetScript   string    `yaml:"set"`
-	Needrestart string    `yaml:"needrestart"`
-	Skip        bool      `yaml:"skip"`
-	Type        string    `yaml:"type"`
-	Step        float32   `yaml:"step,omitempty"`
-	Items       []float32 `yaml:"items"`
-	Options     []string  `yaml:"options"`
-	Scope       []float32 `yaml:"scope,flow"`
-	Dtype       string    `yaml:"dtype"`
-	Ref         string    `yaml:"ref"`
-	Except      string    `yaml:"except"`
-}
-
-// YamlPrjObj :store the yaml object
+	Name        string     `yaml:"name"`
+	Desc        string     `yaml:"desc"`
+	GetScript   string     `yaml:"get"`
+	SetScript   string     `yaml:"set"`
+	Needrestart string     `yaml:"needrestart"`
+	Needreboot  string     `yaml:"needreboot"`
+	Skip        bool       `yaml:"skip"`
+	Type        string     `yaml:"type"`
+	Step        Quantity   `yaml:"step,omitempty"`
+	Items       []Quantity `yaml:"items"`
+	Options     []string   `yaml:"options"`
+	Scope       []Quantity `yaml:"scope,flow"`
+	Dtype       string     `yaml:"dtype"`
+	Ref         string     `yaml:"ref"`
+	Except      string     `yaml:"except"`
+	Transform   string     `yaml:"transform"`
+	Reload      string     `yaml:"reload"`
+}
+
+// YamlPrjObj :store the yaml object, the object is tuned on the groups of
+// the cluster inventory whose name or role is in groups, and it is copied
+// for each of them
 type YamlPrjObj struct {
 	Name      string          `yaml:"name"`
+	Project   string          `yaml:"-"`
 	Info      YamlObj         `yaml:"info"`
 	Relations []*RelationShip `yaml:"relationships"`
+	Groups    []string        `yaml:"groups"`
+	Group     string          `yaml:"-"`
 	Clusters  []interface{}
 }
 
+// Local method return true if the object is set on the local node
+func (o *YamlPrjObj) Local() bool {
+	if o.Group == "" {
+		return len(o.Clusters) == 0 || utils.InArray(o.Clusters, config.Address)
+	}
+	return utils.InArray(o.Clusters, config.Address)
+}
+
 type RelationShip struct {
 	Type    string `yaml:"type"`
 	Target  string `yaml:"target"`
@@ -122,51 +234,66 @@ type RelationShip struct {
 	SrcName string `yaml:"src_name"`
 }
 
-// BenchMark method call the benchmark script
+// BenchMark method call the benchmark script repeat times, the benchmark
+// is run repeat times more if the coefficient of variation is above
+// eval_fluctuation, and the samples of both runs are aggregated
 func (y *YamlPrjCli) BenchMark() (string, string, error) {
-	benchStr := make([]string, 0)
-	log.Debugf("run benchmark script: %s", y.Benchmark)
-	benchOutByte, err := ExecGetOutput(y.Benchmark)
+	samples, err := y.retryBenchMark()
 	if err != nil {
-		fmt.Println(string(benchOutByte))
-		return "", "", fmt.Errorf("failed to run benchmark, err: %v", err)
+		return "", "", err
 	}
-
-	var sum float64
-	for index, evaluation := range y.Evaluations {
-		newScript := strings.Replace(evaluation.Info.Get, "$out", string(benchOutByte), -1)
-		bout, err := ExecGetOutput(newScript)
+	y.setFluctuation(samples)
+	if y.Unstable() {
+		fmt.Printf(" The coefficient of variation (%s) is above eval_fluctuation %g, rerun the benchmark...\n",
+			y.Fluctuation(), y.EvalFluctuation)
+		more, err := y.retryBenchMark()
 		if err != nil {
-			err = fmt.Errorf("failed to exec %s, err: %v", newScript, err)
 			return "", "", err
 		}
+		for index := range samples {
+			samples[index] = append(samples[index], more[index]...)
+		}
+		y.setFluctuation(samples)
+	}
 
-		floatOut, err := strconv.ParseFloat(strings.Replace(string(bout), "\n", "", -1), 64)
+	benchStr := make([]string, 0)
+	var sum float64
+	y.Violations = make([]string, 0)
+	for index, evaluation := range y.Evaluations {
+		floatOut, err := utils.Aggregate(samples[index], evaluation.Info.Aggregate)
 		if err != nil {
-			log.Debugf("output of benchmark script: %s", string(benchOutByte))
-			log.Debugf("output of evaluation script for %s: %s", evaluation.Name, string(bout))
-			err = fmt.Errorf("failed to parse result of the evaluation of %s, err: %v", evaluation.Name, err)
 			return "", "", err
 		}
+		if evaluation.Info.IsConstraint() {
+			satisfied, err := evaluation.Info.Satisfied(floatOut)
+			if err != nil {
+				return "", "", err
+			}
+			if !satisfied {
+				y.Violations = append(y.Violations,
+					fmt.Sprintf("%s=%.2f(%s)", evaluation.Name, floatOut, evaluation.Info.Bound))
+			}
+		}
 
 		if evaluation.Info.Type == "negative" {
 			floatOut = -floatOut
 		}
-		floatOut, _ = strconv.ParseFloat(fmt.Sprintf("%.2f", floatOut), 64)
 		y.EvalCurrentArray[index] = floatOut
 		if y.Baseline {
 			y.EvalBaseArray[index] = floatOut
 			y.EvalMinArray[index] = floatOut
+			y.EvalWorstArray[index] = floatOut
 		}
-		benchStr = append(benchStr, fmt.Sprintf("%s=%.2f", evaluation.Name, floatOut))
+		y.EvalWorstArray[index] = math.Max(y.EvalWorstArray[index], floatOut)
+		benchStr = append(benchStr, evaluation.Name+"="+formatEval(floatOut))
 	}
 
 	sum = y.calculateBenchMark()
 
-	if utils.IsEquals(y.EvalMin, 0.0) && len(y.Evaluations) == 1 {
+	if utils.IsEquals(y.EvalMin, 0.0) && len(y.objectives()) == 1 {
 		y.EvalMin = sum
 	}
-	if !y.FeatureFilter && sum < y.EvalMin {
+	if !y.FeatureFilter && !y.Violated() && sum < y.EvalMin {
 		for index, eval := range y.EvalCurrentArray {
 			y.EvalMinArray[index] = eval
 		}
@@ -174,7 +301,124 @@ func (y *YamlPrjCli) BenchMark() (string, string, error) {
 	}
 	y.EvalCurrent = sum
 	y.Baseline = false
-	return fmt.Sprintf("evaluations=%.2f", sum), strings.Join(benchStr, ","), nil
+	return "evaluations="+formatEval(sum), strings.Join(benchStr, ","), nil
+}
+
+func (y *YamlPrjCli) retryBenchMark() ([][]float64, error) {
+	for retry := 1; ; retry++ {
+		samples, err := y.repeatBenchMark()
+		if err == nil || retry > int(y.BenchmarkRetries) {
+			return samples, err
+		}
+		fmt.Printf(" %v, retry the benchmark(%d/%d)...\n", err, retry, y.BenchmarkRetries)
+	}
+}
+
+func (y *YamlPrjCli) repeatBenchMark() ([][]float64, error) {
+	repeat := int(y.Repeat)
+	if repeat < 1 {
+		repeat = 1
+	}
+	timeout, _ := time.ParseDuration(y.BenchmarkTimeout)
+
+	samples := make([][]float64, len(y.Evaluations))
+	for i := 0; i < repeat; i++ {
+		log.Debugf("run benchmark script(%d/%d): %s", i+1, repeat, y.Benchmark)
+		benchOutByte, err := y.exec(y.Benchmark, timeout)
+		if err != nil {
+			fmt.Println(string(benchOutByte))
+			return nil, fmt.Errorf("failed to run benchmark, err: %v", err)
+		}
+
+		for index, evaluation := range y.Evaluations {
+			floatOut, err := y.evaluate(evaluation, benchOutByte)
+			if err != nil {
+				return nil, err
+			}
+			if math.IsNaN(floatOut) || math.IsInf(floatOut, 0) {
+				return nil, fmt.Errorf("the evaluation of %s is invalid: %v", evaluation.Name, floatOut)
+			}
+			samples[index] = append(samples[index], floatOut)
+		}
+	}
+
+	return samples, nil
+}
+
+func (y *YamlPrjCli) setFluctuation(samples [][]float64) {
+	y.EvalCVArray = make([]float64, len(y.Evaluations))
+	for index := range y.Evaluations {
+		y.EvalCVArray[index] = utils.CoefficientOfVariation(samples[index])
+	}
+}
+
+// evaluate method return the value of the evaluation from the benchmark output,
+// by the extractor if it is set, or else by the get script
+func (y *YamlPrjCli) evaluate(evaluation Evaluate, benchOut []byte) (float64, error) {
+	if evaluation.Info.Extract != nil {
+		value, err := evaluation.Info.Extract.Extract(benchOut)
+		if err != nil {
+			log.Debugf("output of benchmark script: %s", string(benchOut))
+			return 0, fmt.Errorf("failed to extract the evaluation of %s, err: %v", evaluation.Name, err)
+		}
+		return value, nil
+	}
+
+	newScript := strings.Replace(evaluation.Info.Get, "$out", string(benchOut), -1)
+	bout, err := y.exec(newScript, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to exec %s, err: %v", newScript, err)
+	}
+
+	floatOut, err := strconv.ParseFloat(strings.Replace(string(bout), "\n", "", -1), 64)
+	if err != nil {
+		log.Debugf("output of benchmark script: %s", string(benchOut))
+		log.Debugf("output of evaluation script for %s: %s", evaluation.Name, string(bout))
+		return 0, fmt.Errorf("failed to parse result of the evaluation of %s, err: %v",
+			evaluation.Name, err)
+	}
+	return floatOut, nil
+}
+
+// exec method run the script on the benchmark target, or on the local host
+// if the benchmark target is not set
+func (y *YamlPrjCli) exec(script string, timeout time.Duration) ([]byte, error) {
+	if y.Executor == nil {
+		return ExecGetOutputTimeout(script, timeout)
+	}
+	return y.Executor.Exec(script, timeout)
+}
+
+// Violated method return true if the last benchmark violates the bound
+// of any constraint evaluation
+func (y *YamlPrjCli) Violated() bool {
+	return len(y.Violations) > 0
+}
+
+// Unstable method return true if the coefficient of variation of any
+// evaluation is above eval_fluctuation
+func (y *YamlPrjCli) Unstable() bool {
+	if y.Repeat <= 1 || y.EvalFluctuation <= 0 {
+		return false
+	}
+	for _, cv := range y.EvalCVArray {
+		if cv > y.EvalFluctuation {
+			return true
+		}
+	}
+	return false
+}
+
+// Fluctuation method return the coefficient of variation of the evaluations
+func (y *YamlPrjCli) Fluctuation() string {
+	fluctuation := make([]string, 0)
+	for index, evaluation := range y.Evaluations {
+		if index >= len(y.EvalCVArray) {
+			break
+		}
+		fluctuation = append(fluctuation, fmt.Sprintf("%s=%.2f%%", evaluation.Name, y.EvalCVArray[index]*100))
+	}
+	return strings.Join(fluctuation, ",")
 }
 
 func (y *YamlPrjCli) BestPerformance() string {
@@ -201,20 +445,55 @@ func (y *YamlPrjCli) BasePerformance() string {
 	return strings.Join(basePerformance, ",")
 }
 
+// objectives method return the indexes of the evaluations to be optimized
+func (y *YamlPrjCli) objectives() []int {
+	indexes := make([]int, 0, len(y.Evaluations))
+	for index, evaluation := range y.Evaluations {
+		if !evaluation.Info.IsConstraint() {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
+
+// ObjectiveNames method return the names of the evaluations which are the
+// objectives of the tuning
+func (y *YamlPrjCli) ObjectiveNames() []string {
+	names := make([]string, 0, len(y.Evaluations))
+	for _, index := range y.objectives() {
+		names = append(names, y.Evaluations[index].Name)
+	}
+	return names
+}
+
+// formatEval return the evaluation value sent to the optimizer, the value
+// keeps the full precision and is only rounded for display
+func formatEval(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
 func (y *YamlPrjCli) calculateBenchMark() float64 {
-	if len(y.EvalCurrentArray) == 1 {
-		return y.EvalCurrentArray[0]
+	objectives := y.objectives()
+	if len(objectives) == 1 {
+		return y.EvalCurrentArray[objectives[0]]
 	}
 
 	var sum float64
-	for index, evaluation := range y.Evaluations {
-		sum += y.improveRate(index) * float64(evaluation.Info.Weight) / 100
+	for _, index := range objectives {
+		weight := float64(y.Evaluations[index].Info.Weight)
+		if y.ObjectiveMode == ObjectivePareto {
+			weight = 100 / float64(len(objectives))
+		}
+		sum += y.improveRate(index) * weight / 100
 	}
 
 	return -sum
 }
 
 func (y *YamlPrjCli) improveRate(index int) float64 {
+	if y.EvalBaseArray[index] == 0 || y.EvalCurrentArray[index] == 0 {
+		return zeroImproveRate(y.EvalBaseArray[index], y.EvalCurrentArray[index])
+	}
 	if y.EvalBaseArray[index] > 0 {
 		if y.EvalCurrentArray[index] > y.EvalBaseArray[index] {
 			return (y.EvalBaseArray[index] - y.EvalCurrentArray[index]) / y.EvalBaseArray[index] * 100
@@ -227,6 +506,16 @@ func (y *YamlPrjCli) improveRate(index int) float64 {
 	return (y.EvalCurrentArray[index] - y.EvalBaseArray[index]) / y.EvalBaseArray[index] * 100
 }
 
+// zeroImproveRate return the improve rate when the base or the current value
+// is zero, the rate is relative to the other value, and it is 0 if both are zero
+func zeroImproveRate(base float64, current float64) float64 {
+	other := math.Max(math.Abs(base), math.Abs(current))
+	if other == 0 {
+		return 0
+	}
+	return (base - current) / other * 100
+}
+
 // Threshold return the threshold, which replace with the benchmark result.
 // it is used when the parameters is not match with the relations
 func (y *YamlPrjCli) Threshold() (string, string, error) {
@@ -237,10 +526,67 @@ func (y *YamlPrjCli) Threshold() (string, string, error) {
 			floatOut = -floatOut
 		}
 		y.EvalCurrentArray[index] = floatOut
-		benchStr = append(benchStr, fmt.Sprintf("%s=%.2f", evaluation.Name, floatOut))
+		benchStr = append(benchStr, evaluation.Name+"="+formatEval(floatOut))
 	}
 	sum := y.calculateBenchMark()
-	return fmt.Sprintf("evaluations=%.2f", sum), strings.Join(benchStr, ","), nil
+	return "evaluations="+formatEval(sum), strings.Join(benchStr, ","), nil
+}
+
+// Failure method return the evaluation of the iteration whose benchmark
+// failed, no value is measured, so the iteration is reported to the
+// optimizer as infeasible with the penalty of the objectives, and the
+// constraints keep the worst measured values
+func (y *YamlPrjCli) Failure() (string, string, error) {
+	benchStr := make([]string, 0)
+	for index, evaluation := range y.Evaluations {
+		floatOut := y.EvalWorstArray[index]
+		if !evaluation.Info.IsConstraint() {
+			floatOut = y.penalty(index)
+		}
+		y.EvalCurrentArray[index] = floatOut
+		benchStr = append(benchStr, evaluation.Name+"="+formatEval(floatOut))
+	}
+	sum := y.calculateBenchMark()
+	y.EvalCurrent = sum
+	return "evaluations="+formatEval(sum), strings.Join(benchStr, ","), nil
+}
+
+// Infeasible method return the evaluation of the iteration which violates
+// the constraints, the objectives are replaced with the penalty, and the
+// constraints keep the measured values
+func (y *YamlPrjCli) Infeasible() (string, string) {
+	benchStr := make([]string, 0)
+	for index, evaluation := range y.Evaluations {
+		floatOut := y.EvalCurrentArray[index]
+		if !evaluation.Info.IsConstraint() {
+			floatOut = y.penalty(index)
+		}
+		y.EvalCurrentArray[index] = floatOut
+		benchStr = append(benchStr, evaluation.Name+"="+formatEval(floatOut))
+	}
+	sum := y.calculateBenchMark()
+	y.EvalCurrent = sum
+	return "evaluations="+formatEval(sum), strings.Join(benchStr, ",")
+}
+
+// penalty method return the penalty of the evaluation, which falls back to
+// the threshold, and then to the worst measured value seeded by the baseline,
+// in the minimized form, a penalty of 0 is used if it is set
+func (y *YamlPrjCli) penalty(index int) float64 {
+	info := y.Evaluations[index].Info
+	var floatOut float64
+	switch {
+	case info.Penalty != nil:
+		floatOut = *info.Penalty
+	case !utils.IsEquals(info.Threshold, 0.0):
+		floatOut = info.Threshold
+	default:
+		return y.EvalWorstArray[index]
+	}
+	if info.Type == "negative" {
+		floatOut = -floatOut
+	}
+	return floatOut
 }
 
 // SetHistoryEvalBase method call the set the current EvalBase to history baseline
@@ -259,6 +605,7 @@ func (y *YamlPrjCli) SetHistoryEvalBase(tuningHistory *PB.TuningHistory) {
 			return
 		}
 		y.EvalBaseArray[index] = evalFloat
+		y.EvalWorstArray[index] = evalFloat
 	}
 
 	for index, eval := range strings.Split(tuningHistory.MinEval, ",") {
@@ -282,18 +629,43 @@ func (y *YamlPrjCli) SetHistoryEvalBase(tuningHistory *PB.TuningHistory) {
 
 // ImproveRateString method return the string format of performance improve rate
 func (y *YamlPrjCli) ImproveRateString(current float64) string {
-	if len(y.Evaluations) > 1 {
+	objectives := y.objectives()
+	if len(objectives) > 1 {
 		return fmt.Sprintf("%.2f", -current)
 	}
 
-	if y.EvalBaseArray[0] < 0 {
-		return fmt.Sprintf("%.2f", (current-y.EvalBaseArray[0])/y.EvalBaseArray[0]*100)
+	base := y.EvalBaseArray[objectives[0]]
+	if base == 0 || current == 0 {
+		return fmt.Sprintf("%.2f", zeroImproveRate(base, current))
+	}
+	if base < 0 {
+		return fmt.Sprintf("%.2f", (current-base)/base*100)
 	}
-	return fmt.Sprintf("%.2f", (y.EvalBaseArray[0]-current)/math.Abs(current)*100)
+	return fmt.Sprintf("%.2f", (base-current)/math.Abs(current)*100)
 }
 
-// RunSet method call the set script to set the value
-func (y *YamlPrjSvr) RunSet(optStr string) (error, []string) {
+// ApplyError : the params failed to apply, and the knobs already set are
+// reverted to the prior values, so the iteration is failed but the tuning
+// can go on
+type ApplyError struct {
+	Err error
+}
+
+func (e *ApplyError) Error() string {
+	return e.Err.Error()
+}
+
+// priorValue : the value of the knob before it is set by RunSet
+type priorValue struct {
+	obj   *YamlPrjObj
+	name  string
+	value string
+}
+
+// RunSet method call the set script to set the value, the params are applied
+// as a transaction, the prior value of each knob is recorded by its get script
+// and all the knobs are reverted in reverse order if any set script failed
+func (y *YamlPrjSvr) RunSet(optStr string) (error, NodeKnobs) {
 	paraMap := make(map[string]string)
 	paraSlice := strings.Split(optStr, ",")
 	for _, para := range paraSlice {
@@ -304,20 +676,27 @@ func (y *YamlPrjSvr) RunSet(optStr string) (error, []string) {
 		paraMap[kvs[0]] = strings.TrimSpace(kvs[1])
 	}
 	log.Infof("before change paraMap: %+v\n", paraMap)
-	scripts := make([]string, 0)
+	inactive := y.InactiveKnobs(paraMap)
+	for name := range inactive {
+		delete(paraMap, name)
+	}
+	y.diffApplied(paraMap)
+	knobs := make(NodeKnobs)
+	priors := make([]*priorValue, 0)
+	y.requested = make(map[string]string)
 	for _, obj := range y.Object {
 		if obj.Info.Skip {
 			log.Infof("item %s is skiped", obj.Name)
 			continue
 		}
 
+		if inactive[obj.Name] {
+			log.Infof("%s value is not match the relations", obj.Name)
+			continue
+		}
+
 		var objName string
-		active := true
 		for _, relation := range obj.Relations {
-			if relation.Type == RELY_ON && paraMap[relation.Target] != relation.Value {
-				active = false
-				break
-			}
 			if relation.Type == DEPEND_ON && paraMap[relation.Target] != relation.Value {
 				continue
 			}
@@ -334,84 +713,178 @@ func (y *YamlPrjSvr) RunSet(optStr string) (error, []string) {
 			}
 		}
 
-		if !active {
-			log.Infof("%s value is not match the relations", obj.Name)
-			continue
-		}
-
-		script := obj.Info.SetScript
-		var newScript string
-
-		if len(strings.Fields(paraMap[obj.Name])) > 1 {
-			newScript = strings.Replace(script, "$value", "\""+paraMap[obj.Name]+"\"", -1)
-		} else {
-			newScript = strings.Replace(script, "$value", paraMap[obj.Name], -1)
+		newScript := setScript(obj.Info.SetScript, paraMap[obj.Name], objName)
+		y.requested[obj.Name] = paraMap[obj.Name]
+		for _, node := range obj.Clusters {
+			if node != config.Address {
+				knobs[node.(string)] = append(knobs[node.(string)], &KnobValue{
+					Action:  KnobSet,
+					Project: obj.Project,
+					Name:    obj.Name,
+					Group:   obj.Group,
+					Value:   paraMap[obj.Name],
+					Depend:  objName,
+				})
+			}
 		}
 
-		newScript = strings.Replace(newScript, "$name", objName, -1)
-		scripts = append(scripts, newScript)
-
-		objGroupStr := ""
-		reg := regexp.MustCompile(`-[0-9]+$`)
-		res := reg.FindAllString(obj.Name, -1)
-		if len(res) != 0 {
-			for wordPos := 1; wordPos < len(res[0]); wordPos++ {
-				objGroupStr += string(res[0][wordPos])
-			}
-			objGroup, err := strconv.Atoi(string(objGroupStr))
+		if obj.Local() {
+			out, err := ExecGetOutput(obj.Info.GetScript)
 			if err != nil {
-				return err, nil
+				return y.revert(priors, fmt.Errorf("failed to exec %s, err: %v", obj.Info.GetScript, err)), nil
 			}
-			if objGroup == 0 {
-				if utils.InArray(obj.Clusters, config.Address) {
-					log.Infof("set script for %s: %s", obj.Name, newScript)
-					_, err := ExecCommand(newScript)
-					if err != nil {
-						return fmt.Errorf("failed to exec %s, err: %v", newScript, err), nil
-					}
-				} else {
-					log.Infof("no operation")
-				}
-			}
-		} else {
-			if utils.InArray(obj.Clusters, config.Address) || config.TransProtocol == "unix" {
-				log.Infof("set script for %s: %s", obj.Name, newScript)
-				_, err := ExecCommand(newScript)
-				if err != nil {
-					return fmt.Errorf("failed to exec %s, err: %v", newScript, err), nil
-				}
-			} else {
-				log.Infof("no operation")
+			priors = append(priors, &priorValue{obj: obj, name: objName, value: strings.TrimSpace(string(out))})
+
+			log.Infof("set script for %s: %s", obj.Name, newScript)
+			if err = execSetScript(newScript); err != nil {
+				return y.revert(priors, fmt.Errorf("failed to exec %s, err: %v", newScript, err)), nil
 			}
 		}
 	}
 	log.Infof("after change paraMap: %+v\n", paraMap)
-	return nil, scripts
+	return nil, knobs
+}
+
+// revert method set the prior values of the knobs in reverse order after
+// RunSet failed, the knobs are in unknown state if any of them failed
+func (y *YamlPrjSvr) revert(priors []*priorValue, cause error) error {
+	log.Errorf("%v, revert the knobs of %s", cause, y.Project)
+	failed := make([]string, 0)
+	for i := len(priors) - 1; i >= 0; i-- {
+		prior := priors[i]
+		script := setScript(prior.obj.Info.SetScript, prior.value, prior.name)
+		log.Infof("revert %s: %s", prior.obj.Name, script)
+		if err := execSetScript(script); err != nil {
+			log.Errorf("failed to revert %s, err: %v", prior.obj.Name, err)
+			failed = append(failed, prior.obj.Name)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%v, and failed to revert %s", cause, strings.Join(failed, ","))
+	}
+
+	y.applied = y.previous
+	y.requested = nil
+	y.changed = make([]string, 0)
+	return &ApplyError{Err: cause}
+}
+
+// Requested method return the value which the knob is set to by the last
+// RunSet, false if the knob is not set
+func (y *YamlPrjSvr) Requested(name string) (string, bool) {
+	value, ok := y.requested[name]
+	return value, ok
+}
+
+// SetApplied method record the current params of the knobs, which the
+// params of the next RunSet are compared with
+func (y *YamlPrjSvr) SetApplied(params string) {
+	y.applied = make(map[string]string)
+	for _, para := range strings.Split(params, ",") {
+		kvs := strings.SplitN(para, "=", 2)
+		if len(kvs) < 2 {
+			continue
+		}
+		y.applied[kvs[0]] = strings.TrimSpace(kvs[1])
+	}
+}
+
+// diffApplied method record the knobs whose params are changed since the
+// last RunSet, all the knobs are changed if the params are unknown
+func (y *YamlPrjSvr) diffApplied(paraMap map[string]string) {
+	y.previous = nil
+	if y.applied != nil {
+		y.previous = make(map[string]string, len(y.applied))
+		for name, value := range y.applied {
+			y.previous[name] = value
+		}
+	}
+	y.changed = make([]string, 0)
+	for name, value := range paraMap {
+		if old, ok := y.applied[name]; !ok || old != value {
+			y.changed = append(y.changed, name)
+		}
+	}
+	if y.applied == nil {
+		y.applied = make(map[string]string)
+	}
+	for name, value := range paraMap {
+		y.applied[name] = value
+	}
+	log.Infof("changed knobs: %v", y.changed)
+}
+
+// Rollback method set the params applied before the last RunSet again, and
+// return the knobs of the remote nodes
+func (y *YamlPrjSvr) Rollback() (error, NodeKnobs) {
+	if y.previous == nil {
+		return fmt.Errorf("no params of %s to roll back", y.Project), nil
+	}
+	params := make([]string, 0, len(y.previous))
+	for name, value := range y.previous {
+		params = append(params, name+"="+value)
+	}
+	log.Infof("roll back the params of %s: %s", y.Project, strings.Join(params, ","))
+	return y.RunSet(strings.Join(params, ","))
+}
+
+// RebootKnobs method return the changed knobs which need reboot to take effect
+func (y *YamlPrjSvr) RebootKnobs() []string {
+	knobs := make([]string, 0)
+	for _, obj := range y.Object {
+		if obj.Info.Skip || obj.Info.Needreboot != "true" || !utils.CheckValueInSlice(obj.Name, y.changed) {
+			continue
+		}
+		knobs = append(knobs, obj.Name)
+	}
+	return knobs
 }
 
 // RestartProject method call the StartWorkload and StopWorkload script to restart the service
-func (y *YamlPrjSvr) RestartProject() (error, []string) {
+// if a changed knob needs restart, the reload scripts of the changed knobs are called instead
+// if all of them can be reloaded
+func (y *YamlPrjSvr) RestartProject() (error, NodeKnobs) {
 	startWorkload := y.Startworkload
 	stopWorkload := y.Stopworkload
 
 	needRestart := false
-
+	reloads := make([]string, 0)
+	reloadObjs := make([]*YamlPrjObj, 0)
 	for _, obj := range y.Object {
+		if obj.Info.Skip || !utils.CheckValueInSlice(obj.Name, y.changed) {
+			continue
+		}
+		if obj.Info.Reload != "" {
+			if !utils.CheckValueInSlice(obj.Info.Reload, reloads) {
+				reloads = append(reloads, obj.Info.Reload)
+				reloadObjs = append(reloadObjs, obj)
+			}
+			continue
+		}
 		if obj.Info.Needrestart == "true" {
 			needRestart = true
-			break
 		}
 	}
 
-	scripts := make([]string, 0)
-	if needRestart {
+	knobs := make([]*KnobValue, 0)
+	if !needRestart {
+		for index, reload := range reloads {
+			log.Debugf("reload script is: %s", reload)
+			out, err := ExecCommand(reload)
+			if err != nil {
+				return fmt.Errorf("failed to exec %s, err: %v", reload, err), nil
+			}
+			log.Debug(string(out))
+			obj := reloadObjs[index]
+			knobs = append(knobs, &KnobValue{Action: KnobReload, Project: obj.Project, Name: obj.Name, Group: obj.Group})
+		}
+	} else {
 		log.Debugf("stop workload script is: %s", stopWorkload)
 		out, err := ExecCommand(stopWorkload)
 		if err != nil {
 			return fmt.Errorf("failed to exec %s, err: %v", stopWorkload, err), nil
 		}
 		log.Debug(string(out))
-		scripts = append(scripts, stopWorkload)
 
 		log.Debugf("start workload script is: %s", startWorkload)
 		out, err = ExecCommand(startWorkload)
@@ -420,19 +893,43 @@ func (y *YamlPrjSvr) RestartProject() (error, []string) {
 		}
 		log.Debug(string(out))
 
-		scripts = append(scripts, startWorkload)
+		knobs = append(knobs, &KnobValue{Action: KnobRestart, Project: y.Project})
 	}
 
-	return nil, scripts
+	remote := make(NodeKnobs)
+	if len(knobs) == 0 {
+		return nil, remote
+	}
+	for _, obj := range y.Object {
+		if obj.Info.Skip {
+			continue
+		}
+		for _, node := range obj.Clusters {
+			if _, ok := remote[node.(string)]; !ok && node != config.Address {
+				remote[node.(string)] = knobs
+			}
+		}
+	}
+	return nil, remote
 }
 
 // MergeProject two yaml project to one object
 func (y *YamlPrjSvr) MergeProject(prj *YamlPrjSvr) {
 	y.Object = append(y.Object, prj.Object...)
+	y.Constraints = append(y.Constraints, prj.Constraints...)
 }
 
-// MatchRelations method check if the params match the relations
-// if less or greater is not match, return false, else return true
+// Knobs method return the object names of the project
+func (y *YamlPrjSvr) Knobs() []string {
+	knobs := make([]string, 0, len(y.Object))
+	for _, obj := range y.Object {
+		knobs = append(knobs, obj.Name)
+	}
+	return knobs
+}
+
+// MatchRelations method check if the params match the relations and constraints
+// if less, greater or a constraint is not match, return false, else return true
 func (y *YamlPrjSvr) MatchRelations(optStr string) bool {
 	paraMap := make(map[string]string)
 	paraSlice := strings.Split(optStr, ",")
@@ -443,6 +940,10 @@ func (y *YamlPrjSvr) MatchRelations(optStr string) bool {
 		}
 		paraMap[kvs[0]] = kvs[1]
 	}
+	inactive := y.InactiveKnobs(paraMap)
+	for name := range inactive {
+		delete(paraMap, name)
+	}
 
 	for _, obj := range y.Object {
 		if obj.Info.Skip {
@@ -453,9 +954,12 @@ func (y *YamlPrjSvr) MatchRelations(optStr string) bool {
 			if relation.Type != LESS && relation.Type != GREATER {
 				continue
 			}
+			if inactive[obj.Name] || inactive[relation.Target] {
+				continue
+			}
 
-			targetValue, _ := strconv.Atoi(paraMap[relation.Target])
-			objValue, _ := strconv.Atoi(paraMap[obj.Name])
+			targetValue, _ := strconv.ParseFloat(strings.TrimSpace(paraMap[relation.Target]), 64)
+			objValue, _ := strconv.ParseFloat(strings.TrimSpace(paraMap[obj.Name]), 64)
 
 			if relation.Type == LESS && objValue > targetValue {
 				return false
@@ -466,6 +970,173 @@ func (y *YamlPrjSvr) MatchRelations(optStr string) bool {
 			}
 		}
 	}
+	return y.matchConstraints(paraMap)
+}
+
+// CheckTransforms method check the transform of the knobs
+func (y *YamlPrjSvr) CheckTransforms() error {
+	for _, obj := range y.Object {
+		if err := obj.Info.CheckTransform(obj.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InRange method return true if the value is in the options, the items or
+// the scope of the knob
+func (o *YamlObj) InRange(value string) bool {
+	value = strings.TrimSpace(value)
+	if len(o.Options) > 0 {
+		return utils.CheckValueInSlice(value, o.Options)
+	}
+	number, err := ParseQuantity(value)
+	if err != nil {
+		return false
+	}
+	for _, item := range o.Items {
+		if utils.IsEquals(number, float64(item)) {
+			return true
+		}
+	}
+	if len(o.Scope) == 2 {
+		return number >= float64(o.Scope[0]) && number <= float64(o.Scope[1])
+	}
+	return len(o.Items) == 0
+}
+
+// MatchValue return true if the value read back from the knob matches the
+// requested value, the numbers are compared with the relative tolerance and
+// the values of multiple fields are compared field by field
+func MatchValue(requested string, actual string, tolerance float64) bool {
+	reqFields := strings.Fields(requested)
+	actFields := strings.Fields(actual)
+	if len(reqFields) != len(actFields) {
+		return false
+	}
+	for i := range reqFields {
+		if reqFields[i] == actFields[i] {
+			continue
+		}
+		reqNumber, err := ParseQuantity(reqFields[i])
+		if err != nil {
+			return false
+		}
+		actNumber, err := ParseQuantity(actFields[i])
+		if err != nil {
+			return false
+		}
+		if math.Abs(actNumber-reqNumber) > tolerance*math.Max(math.Abs(reqNumber), 1) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckRelations method return the problems of the relations and the
+// constraints, such as the unknown targets, the values out of the range
+// of the targets and the relations which are never satisfied
+func (y *YamlPrjSvr) CheckRelations() []string {
+	objs := make(map[string]*YamlPrjObj)
+	for _, obj := range y.Object {
+		objs[obj.Name] = obj
+	}
+
+	problems := make([]string, 0)
+	if err := y.CheckConditions(); err != nil {
+		problems = append(problems, err.Error())
+	}
+	for _, obj := range y.Object {
+		for _, relation := range obj.Relations {
+			target, ok := objs[relation.Target]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("the target %s of the %s relation of %s is not a knob",
+					relation.Target, relation.Type, obj.Name))
+				continue
+			}
+			switch relation.Type {
+			case RELY_ON, DEPEND_ON:
+				if !target.Info.InRange(relation.Value) {
+					problems = append(problems, fmt.Sprintf("the value %s of the %s relation of %s is out of the range of %s",
+						relation.Value, relation.Type, obj.Name, target.Name))
+				}
+			case LESS, GREATER:
+				if len(obj.Info.Scope) != 2 || len(target.Info.Scope) != 2 {
+					continue
+				}
+				if relation.Type == LESS && obj.Info.Scope[0] > target.Info.Scope[1] ||
+					relation.Type == GREATER && obj.Info.Scope[1] < target.Info.Scope[0] {
+					problems = append(problems, fmt.Sprintf("the %s relation of %s and %s is never satisfied by their scopes",
+						relation.Type, obj.Name, target.Name))
+				}
+			case MULTIPLE:
+			default:
+				problems = append(problems, fmt.Sprintf("the relation type %s of %s is unknown", relation.Type, obj.Name))
+			}
+		}
+	}
+
+	return append(problems, y.constraintProblems()...)
+}
+
+// CheckConstraints method check the syntax of the constraints and that each
+// variable of the constraints is a knob or a host fact
+func (y *YamlPrjSvr) CheckConstraints() error {
+	if problems := y.constraintProblems(); len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (y *YamlPrjSvr) constraintProblems() []string {
+	problems := make([]string, 0)
+	if len(y.Constraints) == 0 {
+		return problems
+	}
+
+	knobs := y.Knobs()
+	facts := constraint.HostFacts()
+	for _, expr := range y.Constraints {
+		c, err := constraint.Parse(expr)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		for _, name := range c.Vars() {
+			if utils.CheckValueInSlice(name, knobs) {
+				continue
+			}
+			if _, ok := facts[name]; !ok {
+				problems = append(problems, fmt.Sprintf("the variable %s of the constraint %q is not a knob or a host fact",
+					name, expr))
+			}
+		}
+	}
+	return problems
+}
+
+func (y *YamlPrjSvr) matchConstraints(paraMap map[string]string) bool {
+	if len(y.Constraints) == 0 {
+		return true
+	}
+
+	facts := constraint.HostFacts()
+	for _, expr := range y.Constraints {
+		c, err := constraint.Parse(expr)
+		if err != nil {
+			log.Errorf("%v", err)
+			return false
+		}
+		matched, err := c.Check(paraMap, facts)
+		if err != nil {
+			log.Errorf("failed to check the constraint %s: %v", expr, err)
+			return false
+		}
+		if !matched {
+			log.Infof("params violate the constraint: %s", expr)
+			return false
+		}
+	}
 	return true
 }
 
@@ -483,8 +1154,59 @@ func ExecCommand(script string) ([]byte, error) {
 	return buf.Bytes(), err
 }
 
+// execSetScript exec the set script of the knob, it fails if the script exits
+// with a non-zero status, which ExecCommand does not check
+func execSetScript(script string) error {
+	out, err := ExecGetOutput(script)
+	if err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
 //exec command and get complete output including subprocess
 func ExecGetOutput(script string) ([]byte, error) {
 	cmd := exec.Command("sh", "-c", script)
 	return cmd.CombinedOutput()
 }
+
+// ExecGetOutputTimeout exec command and get complete output, the command and
+// all its subprocess are killed if it is not finished in timeout
+func ExecGetOutputTimeout(script string, timeout time.Duration) ([]byte, error) {
+	if timeout <= 0 {
+		return ExecGetOutput(script)
+	}
+
+	var out bytes.Buffer
+	err := ExecWriteTimeout(script, timeout, &out)
+	return out.Bytes(), err
+}
+
+// ExecWriteTimeout exec command and write the output to out as it is
+// produced, the command and all its subprocess are killed if it is not
+// finished in timeout, there is no timeout if it is not positive
+func ExecWriteTimeout(script string, timeout time.Duration, out io.Writer) error {
+	cmd := exec.Command("sh", "-c", script)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	if timeout <= 0 {
+		return <-done
+	}
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		_ = syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		<-done
+		return fmt.Errorf("timeout after %s", timeout)
+	}
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package project
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+)
+
+func TestImproveRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    float64
+		current float64
+		want    float64
+	}{
+		{"lower latency", 10, 5, 100},
+		{"higher latency", 10, 20, -100},
+		{"higher throughput", -100, -150, 50},
+		{"lower throughput", -100, -50, -100},
+		{"zero baseline and worse", 0, 5, -100},
+		{"zero baseline and better", 0, -10, 100},
+		{"zero current and better", 10, 0, 100},
+		{"zero current and worse", -100, 0, -100},
+		{"both zero", 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y := &YamlPrjCli{
+				Evaluations:      []Evaluate{{Name: "a", Info: EvalInfo{Weight: 100}}},
+				EvalBaseArray:    []float64{tt.base},
+				EvalCurrentArray: []float64{tt.current},
+			}
+			got := y.improveRate(0)
+			if math.IsNaN(got) || math.IsInf(got, 0) || math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("improveRate of %v to %v = %v, want %v", tt.base, tt.current, got, tt.want)
+			}
+			if rate := y.ImproveRateString(tt.current); rate == "NaN" || rate == "+Inf" || rate == "-Inf" {
+				t.Errorf("ImproveRateString of %v to %v = %s", tt.base, tt.current, rate)
+			}
+		})
+	}
+}
+
+func TestPenalty(t *testing.T) {
+	zero, latency := 0.0, 50.0
+	tests := []struct {
+		name string
+		info EvalInfo
+		want float64
+	}{
+		{"zero penalty", EvalInfo{Type: "positive", Penalty: &zero, Threshold: 30}, 0},
+		{"negative penalty", EvalInfo{Type: "negative", Penalty: &latency, Threshold: 30}, -50},
+		{"threshold", EvalInfo{Type: "negative", Threshold: 30}, -30},
+		{"worst value", EvalInfo{Type: "positive"}, 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			y := &YamlPrjCli{
+				Evaluations:    []Evaluate{{Name: "a", Info: tt.info}},
+				EvalWorstArray: []float64{20},
+			}
+			if got := y.penalty(0); got != tt.want {
+				t.Errorf("penalty = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorstFromBaseline(t *testing.T) {
+	y := &YamlPrjCli{
+		Evaluations:      []Evaluate{{Name: "a", Info: EvalInfo{Type: "negative", Weight: 100}}},
+		EvalBaseArray:    make([]float64, 1),
+		EvalMinArray:     make([]float64, 1),
+		EvalWorstArray:   make([]float64, 1),
+		EvalCurrentArray: make([]float64, 1),
+		Baseline:         true,
+	}
+	y.SetHistoryEvalBase(&PB.TuningHistory{BaseEval: "a=-100", MinEval: "a=-120", SumEval: "-120"})
+	if y.EvalWorstArray[0] != -100 {
+		t.Errorf("the worst value is %v, want the baseline -100", y.EvalWorstArray[0])
+	}
+	if _, detail, err := y.Failure(); err != nil || detail != "a=-100" {
+		t.Errorf("the failure is reported as %s, %v, want the baseline a=-100", detail, err)
+	}
+}
+
+func TestSatisfied(t *testing.T) {
+	tests := []struct {
+		bound string
+		value float64
+		want  bool
+		fail  bool
+	}{
+		{"< 20", 10, true, false},
+		{"< 20", 20, false, false},
+		{">= 0.99", 0.995, true, false},
+		{">= 0.99", 0.9, false, false},
+		{"< limit", 10, false, true},
+		{"<", 10, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.bound, func(t *testing.T) {
+			info := EvalInfo{Role: RoleConstraint, Bound: tt.bound}
+			got, err := info.Satisfied(tt.value)
+			if tt.fail {
+				if err == nil || info.CheckBound() == nil {
+					t.Errorf("the bound %q is accepted, want an error", tt.bound)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Satisfied(%v) of %q = %v, %v, want %v", tt.value, tt.bound, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestInfeasible(t *testing.T) {
+	y := &YamlPrjCli{
+		Evaluations: []Evaluate{
+			{Name: "tps", Info: EvalInfo{Type: "negative", Weight: 100, Threshold: 10}},
+			{Name: "latency", Info: EvalInfo{Type: "positive", Role: RoleConstraint, Bound: "< 20"}},
+		},
+		EvalWorstArray:   []float64{-50, 30},
+		EvalCurrentArray: []float64{-200, 25},
+	}
+	_, detail := y.Infeasible()
+	if detail != "tps=-10,latency=25" {
+		t.Errorf("the infeasible iteration is reported as %s, want the penalty and the measured constraint", detail)
+	}
+}
+
+func TestRestartProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		applied string
+		params  string
+		want    string
+	}{
+		{"no knob needs restart", "a=1,b=1,c=1", "a=1,b=1,c=2", ""},
+		{"reloaded knob", "a=1,b=1,c=1", "a=1,b=2,c=1", "reload"},
+		{"restarted knob", "a=1,b=1,c=1", "a=2,b=2,c=1", "stop start"},
+		{"unchanged params", "a=1,b=1,c=1", "a=1,b=1,c=1", ""},
+		{"unknown params", "", "a=1,b=1,c=1", "stop start"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "restart")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			actions := path.Join(dir, "actions")
+
+			y := &YamlPrjSvr{Project: "test", Startworkload: "echo start >> " + actions,
+				Stopworkload: "echo stop >> " + actions}
+			for _, name := range []string{"a", "b", "c"} {
+				obj := &YamlPrjObj{Name: name, Info: YamlObj{GetScript: "echo 1", SetScript: "true"}}
+				switch name {
+				case "a":
+					obj.Info.Needrestart = "true"
+				case "b":
+					obj.Info.Needrestart = "true"
+					obj.Info.Reload = "echo reload >> " + actions
+				}
+				y.Object = append(y.Object, obj)
+			}
+			if tt.applied != "" {
+				y.SetApplied(tt.applied)
+			}
+
+			if err, _ := y.RunSet(tt.params); err != nil {
+				t.Fatalf("RunSet failed: %v", err)
+			}
+			if err, _ := y.RestartProject(); err != nil {
+				t.Fatalf("RestartProject failed: %v", err)
+			}
+			content, _ := ioutil.ReadFile(actions)
+			if got := strings.Join(strings.Fields(string(content)), " "); got != tt.want {
+				t.Errorf("the workload actions are %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunSetRevert(t *testing.T) {
+	tests := []struct {
+		name   string
+		get    string
+		set    string
+		revert string
+		want   string
+		apply  bool
+	}{
+		{"applied", "cat {}", "echo $value > {}", "", "a=5,b=6,c=7", false},
+		{"set failed", "cat {}", "test $value != 6 && echo $value > {}", "", "a=1,b=2,c=3", true},
+		{"get failed", "exit 1", "echo $value > {}", "", "a=1,b=2,c=3", true},
+		{"revert failed", "cat {}", "exit 1", "test $value != 1 && echo $value > {}", "a=5,b=2,c=3", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "runset")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+
+			y := &YamlPrjSvr{Project: "test"}
+			for index, name := range []string{"a", "b", "c"} {
+				file := path.Join(dir, name)
+				if err := ioutil.WriteFile(file, []byte(strconv.Itoa(index+1)+"\n"), 0600); err != nil {
+					t.Fatal(err)
+				}
+				obj := &YamlPrjObj{Name: name, Info: YamlObj{
+					GetScript: "cat " + file, SetScript: "echo $value > " + file}}
+				if name == "a" && tt.revert != "" {
+					obj.Info.SetScript = strings.Replace(tt.revert, "{}", file, -1)
+				}
+				if name == "b" {
+					obj.Info.GetScript = strings.Replace(tt.get, "{}", file, -1)
+					obj.Info.SetScript = strings.Replace(tt.set, "{}", file, -1)
+				}
+				y.Object = append(y.Object, obj)
+			}
+
+			err, _ = y.RunSet("a=5,b=6,c=7")
+			_, isApplyError := err.(*ApplyError)
+			if tt.want == "a=5,b=6,c=7" && err != nil {
+				t.Fatalf("RunSet failed: %v", err)
+			}
+			if tt.want != "a=5,b=6,c=7" && (err == nil || isApplyError != tt.apply) {
+				t.Fatalf("RunSet returns %v, want an apply error %v", err, tt.apply)
+			}
+			values := make([]string, 0)
+			for _, name := range []string{"a", "b", "c"} {
+				content, _ := ioutil.ReadFile(path.Join(dir, name))
+				values = append(values, name+"="+strings.TrimSpace(string(content)))
+			}
+			if got := strings.Join(values, ","); got != tt.want {
+				t.Errorf("the knobs are %s after RunSet, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchValue(t *testing.T) {
+	tests := []struct {
+		requested string
+		actual    string
+		tolerance float64
+		want      bool
+	}{
+		{"on", "on", 0, true},
+		{"on", "off", 0.5, false},
+		{"1000", "1000", 0, true},
+		{"1000", "1005", 0.01, true},
+		{"1000", "1011", 0.01, false},
+		{"1000", "990", 0.01, true},
+		{"0", "0.5", 0.01, false},
+		{"0", "0.005", 0.01, true},
+		{"4K", "4096", 0, true},
+		{"64G", "68719476736", 0, true},
+		{"4096 87380 6291456", "4096  87380\t6291456", 0, true},
+		{"4096 87380 6291456", "4096 87380 4194304", 0.01, false},
+		{"4096 87380", "4096 87380 6291456", 0.01, false},
+		{"1000", "x", 0.5, false},
+		{"", "", 0, true},
+	}
+	for _, tt := range tests {
+		if got := MatchValue(tt.requested, tt.actual, tt.tolerance); got != tt.want {
+			t.Errorf("MatchValue(%q, %q, %v) = %v, want %v", tt.requested, tt.actual, tt.tolerance, got, tt.want)
+		}
+	}
+}
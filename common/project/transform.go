@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package project
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// the transform between the value of the knob and the search space of the optimizer
+const (
+	TransformLinear = "linear"
+	TransformLog    = "log"
+	TransformPow2   = "pow2"
+)
+
+var quantityUnits = []struct {
+	suffix string
+	value  float64
+}{
+	{"T", 1 << 40},
+	{"G", 1 << 30},
+	{"M", 1 << 20},
+	{"K", 1 << 10},
+}
+
+// Quantity : the number in yaml which can have a binary unit, such as 4K or 64G
+type Quantity float64
+
+// ParseQuantity parse the number with the unit K, M, G or T, the
+// suffix B or iB is allowed, such as 4KB and 4KiB
+func ParseQuantity(value string) (float64, error) {
+	str := strings.ToUpper(strings.TrimSpace(value))
+	str = strings.TrimSuffix(strings.TrimSuffix(str, "B"), "I")
+	scale := 1.0
+	for _, unit := range quantityUnits {
+		if strings.HasSuffix(str, unit.suffix) {
+			str = strings.TrimSpace(strings.TrimSuffix(str, unit.suffix))
+			scale = unit.value
+			break
+		}
+	}
+	number, err := strconv.ParseFloat(str, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantity %s", value)
+	}
+	return number * scale, nil
+}
+
+// UnmarshalYAML method parse the number or the string with unit
+func (q *Quantity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err != nil {
+		return err
+	}
+	number, err := ParseQuantity(str)
+	if err != nil {
+		return err
+	}
+	*q = Quantity(number)
+	return nil
+}
+
+// MarshalYAML method write the quantity with the largest exact unit
+func (q Quantity) MarshalYAML() (interface{}, error) {
+	value := float64(q)
+	for _, unit := range quantityUnits {
+		if value != 0 && math.Mod(value, unit.value) == 0 {
+			return strconv.FormatFloat(value/unit.value, 'f', -1, 64) + unit.suffix, nil
+		}
+	}
+	return value, nil
+}
+
+// Floats return the quantities of the knob sent to the optimizer
+func Floats(quantities []Quantity) []float64 {
+	if quantities == nil {
+		return nil
+	}
+	floats := make([]float64, 0, len(quantities))
+	for _, q := range quantities {
+		floats = append(floats, float64(q))
+	}
+	return floats
+}
+
+// Transformed method return true if the knob is searched in the log space
+func (o *YamlObj) Transformed() bool {
+	return o.Transform == TransformLog || o.Transform == TransformPow2
+}
+
+// CheckTransform method check the transform of the knob
+func (o *YamlObj) CheckTransform(name string) error {
+	switch o.Transform {
+	case "", TransformLinear:
+		return nil
+	case TransformLog, TransformPow2:
+	default:
+		return fmt.Errorf("the transform of %s must be %s, %s or %s", name,
+			TransformLinear, TransformLog, TransformPow2)
+	}
+	if len(o.Scope) != 2 || o.Scope[0] <= 0 || o.Scope[0] > o.Scope[1] {
+		return fmt.Errorf("the %s transform of %s needs a positive scope", o.Transform, name)
+	}
+	if o.Transform == TransformPow2 &&
+		math.Floor(math.Log2(float64(o.Scope[1]))) < math.Ceil(math.Log2(float64(o.Scope[0]))) {
+		return fmt.Errorf("the scope of %s has no power of two", name)
+	}
+	return nil
+}
+
+// SearchSpace method return the type, dtype, range and step of the knob
+// seen by the optimizer, log searches the continuous log2 of the value and
+// pow2 searches the integer exponent of two
+func (o *YamlObj) SearchSpace() (string, string, []float64, float64) {
+	lower, upper := math.Log2(float64(o.Scope[0])), math.Log2(float64(o.Scope[1]))
+	if o.Transform == TransformPow2 {
+		return "discrete", "int", []float64{math.Ceil(lower), math.Floor(upper)}, 1
+	}
+	return "continuous", "float", []float64{lower, upper}, 0
+}
+
+// Encode method convert the value of the knob to the search space
+func (o *YamlObj) Encode(value string) string {
+	if !o.Transformed() {
+		return value
+	}
+	number, err := ParseQuantity(value)
+	if err != nil {
+		return value
+	}
+	_, _, scope, _ := o.SearchSpace()
+	number = math.Log2(math.Max(number, float64(o.Scope[0])))
+	number = math.Min(math.Max(number, scope[0]), scope[1])
+	if o.Transform == TransformPow2 {
+		return strconv.FormatInt(int64(math.Round(number)), 10)
+	}
+	return strconv.FormatFloat(number, 'f', 6, 64)
+}
+
+// Decode method convert the value in the search space to the value of the
+// knob, which is rounded to the step and the dtype
+func (o *YamlObj) Decode(value string) string {
+	if !o.Transformed() {
+		return value
+	}
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return value
+	}
+	lower, upper := float64(o.Scope[0]), float64(o.Scope[1])
+	number = math.Pow(2, number)
+	if o.Transform == TransformLog && o.Step > 0 {
+		number = lower + math.Round((number-lower)/float64(o.Step))*float64(o.Step)
+	}
+	number = math.Min(math.Max(number, lower), upper)
+	if o.Dtype == "float" {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return strconv.FormatInt(int64(math.Round(number)), 10)
+}
+
+func (y *YamlPrjSvr) transformParams(params string, decode bool) string {
+	if params == "" {
+		return params
+	}
+	objs := make(map[string]*YamlPrjObj, len(y.Object))
+	for _, obj := range y.Object {
+		objs[obj.Name] = obj
+	}
+	paraSlice := strings.Split(params, ",")
+	for i, para := range paraSlice {
+		kvs := strings.SplitN(para, "=", 2)
+		if len(kvs) != 2 {
+			continue
+		}
+		obj, ok := objs[strings.TrimSpace(kvs[0])]
+		if !ok || !obj.Info.Transformed() {
+			continue
+		}
+		if decode {
+			paraSlice[i] = kvs[0] + "=" + obj.Info.Decode(kvs[1])
+		} else {
+			paraSlice[i] = kvs[0] + "=" + obj.Info.Encode(kvs[1])
+		}
+	}
+	return strings.Join(paraSlice, ",")
+}
+
+// EncodeParams method convert the params of the knobs to the search space
+func (y *YamlPrjSvr) EncodeParams(params string) string {
+	return y.transformParams(params, false)
+}
+
+// DecodeParams method convert the params from the optimizer to the values of the knobs
+func (y *YamlPrjSvr) DecodeParams(params string) string {
+	return y.transformParams(params, true)
+}
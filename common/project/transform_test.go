@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package project
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		value string
+		want  float64
+		fail  bool
+	}{
+		{"4096", 4096, false},
+		{"0.5", 0.5, false},
+		{"4K", 4 << 10, false},
+		{"4kb", 4 << 10, false},
+		{"4KiB", 4 << 10, false},
+		{" 16 M ", 16 << 20, false},
+		{"64G", 64 << 30, false},
+		{"1.5T", 1.5 * (1 << 40), false},
+		{"G", 0, true},
+		{"4X", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseQuantity(tt.value)
+			if tt.fail {
+				if err == nil {
+					t.Errorf("ParseQuantity succeeded with %v, want an error", got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("ParseQuantity = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantityYaml(t *testing.T) {
+	var obj YamlObj
+	if err := yaml.Unmarshal([]byte("scope: [4K, 64G]\nitems: [1, 2M]\nstep: 4K\n"), &obj); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if obj.Scope[0] != 4<<10 || obj.Scope[1] != 64<<30 || obj.Items[1] != 2<<20 || obj.Step != 4<<10 {
+		t.Errorf("the quantities are %v, %v, %v", obj.Scope, obj.Items, obj.Step)
+	}
+
+	tests := []struct {
+		quantity Quantity
+		want     string
+	}{
+		{64 << 30, "64G\n"},
+		{1536, "1536\n"},
+		{3 << 20, "3M\n"},
+		{0, "0\n"},
+	}
+	for _, tt := range tests {
+		out, err := yaml.Marshal(tt.quantity)
+		if err != nil || string(out) != tt.want {
+			t.Errorf("Marshal of %v = %q, %v, want %q", float64(tt.quantity), out, err, tt.want)
+		}
+	}
+}
+
+func TestFloats(t *testing.T) {
+	if Floats(nil) != nil {
+		t.Errorf("Floats of no quantities is not nil")
+	}
+	// the byte quantities are beyond the precision of float32
+	scope := []Quantity{64<<30 + 1, 1<<40 + 3}
+	for i, f := range Floats(scope) {
+		if f != float64(scope[i]) {
+			t.Errorf("Floats of %v = %v, the precision is lost", float64(scope[i]), f)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	tests := []struct {
+		name   string
+		obj    YamlObj
+		space  []float64
+		encode map[string]string
+		decode map[string]string
+	}{
+		{
+			name:   "log",
+			obj:    YamlObj{Transform: TransformLog, Dtype: "int", Scope: []Quantity{1 << 10, 1 << 30}},
+			space:  []float64{10, 30},
+			encode: map[string]string{"1M": "20.000000", "1": "10.000000", "4G": "30.000000", "x": "x"},
+			decode: map[string]string{"20": "1048576", "40": "1073741824", "0": "1024"},
+		},
+		{
+			name:   "log with step",
+			obj:    YamlObj{Transform: TransformLog, Dtype: "int", Scope: []Quantity{4 << 10, 64 << 30}, Step: 4 << 10},
+			space:  []float64{12, 36},
+			decode: map[string]string{"20.1": "1122304", "36": "68719476736"},
+		},
+		{
+			name:   "pow2",
+			obj:    YamlObj{Transform: TransformPow2, Dtype: "int", Scope: []Quantity{3, 100}},
+			space:  []float64{2, 6},
+			encode: map[string]string{"16": "4", "20": "4", "1": "2", "128": "6"},
+			decode: map[string]string{"5": "32", "7": "100"},
+		},
+		{
+			name:   "linear",
+			obj:    YamlObj{Transform: TransformLinear, Dtype: "int", Scope: []Quantity{1, 100}},
+			encode: map[string]string{"50": "50"},
+			decode: map[string]string{"50": "50"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.obj.CheckTransform("a"); err != nil {
+				t.Fatalf("CheckTransform failed: %v", err)
+			}
+			if tt.space != nil {
+				_, _, space, _ := tt.obj.SearchSpace()
+				if space[0] != tt.space[0] || space[1] != tt.space[1] {
+					t.Errorf("SearchSpace = %v, want %v", space, tt.space)
+				}
+			}
+			for value, want := range tt.encode {
+				if got := tt.obj.Encode(value); got != want {
+					t.Errorf("Encode(%s) = %s, want %s", value, got, want)
+				}
+			}
+			for value, want := range tt.decode {
+				if got := tt.obj.Decode(value); got != want {
+					t.Errorf("Decode(%s) = %s, want %s", value, got, want)
+				}
+			}
+		})
+	}
+
+	invalid := []YamlObj{
+		{Transform: "exp", Scope: []Quantity{1, 10}},
+		{Transform: TransformLog, Scope: []Quantity{0, 10}},
+		{Transform: TransformLog, Scope: []Quantity{10, 1}},
+		{Transform: TransformPow2, Scope: []Quantity{5, 7}},
+	}
+	for _, obj := range invalid {
+		if err := obj.CheckTransform("a"); err == nil {
+			t.Errorf("CheckTransform of %s %v succeeded, want an error", obj.Transform, obj.Scope)
+		}
+	}
+}
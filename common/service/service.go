@@ -57,6 +57,12 @@ type SvrService interface {
 	Healthy(opts ...interface{}) error
 }
 
+// ReadyService :the server service which has work to do before atuned
+// notifies systemd that it is ready
+type ReadyService interface {
+	Ready() error
+}
+
 // CliService :the interface for grpc client
 type CliService interface {
 	Register() error
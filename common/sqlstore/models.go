@@ -23,6 +23,15 @@ var (
 	ErrServiceNotFound = errors.New("service not fount")
 )
 
+// status of the tuning run
+const (
+	TuningRunning     = "running"
+	TuningFinished    = "finished"
+	TuningInterrupted = "interrupted"
+	TuningStopped     = "stopped"
+	TuningRebooting   = "rebooting"
+)
+
 // ClassApps : table class_apps
 type ClassApps struct {
 	Class         string `xorm:"class"`
@@ -86,6 +95,35 @@ type Schedule struct {
 	Strategy string `xorm:"strategy"`
 }
 
+// TuningRun : table tuning_run, one optimizer task of the tuning job
+type TuningRun struct {
+	ID            int64     `xorm:"pk autoincr 'id'"`
+	JobID         string    `xorm:"job_id"`
+	Project       string    `xorm:"project"`
+	Engine        string    `xorm:"engine"`
+	TaskID        string    `xorm:"task_id"`
+	FeatureFilter bool      `xorm:"feature_filter"`
+	MaxIterations int32     `xorm:"max_iterations"`
+	Status        string    `xorm:"status"`
+	Importance    string    `xorm:"importance"`
+	StartTime     time.Time `xorm:"start_time"`
+	EndTime       time.Time `xorm:"end_time"`
+}
+
+// TuningIteration : table tuning_iteration, the iteration 0 is the baseline
+type TuningIteration struct {
+	ID          int64     `xorm:"pk autoincr 'id'"`
+	RunID       int64     `xorm:"run_id"`
+	Iteration   int       `xorm:"iteration"`
+	Params      string    `xorm:"params"`
+	Applied     string    `xorm:"applied"`
+	Evaluations string    `xorm:"evaluations"`
+	EvalSum     float64   `xorm:"eval_sum"`
+	Status      string    `xorm:"status"`
+	StartTime   time.Time `xorm:"start_time"`
+	EndTime     time.Time `xorm:"end_time"`
+}
+
 // GetClass : inquery the class_profile table
 type GetClass struct {
 	Active bool
@@ -323,3 +323,84 @@ func GetSchedule() []*Schedule {
 
 	return items
 }
+
+// InsertTuningRun method insert the tuning run, the id of value is set after insert
+func InsertTuningRun(value *TuningRun) error {
+	session := globalEngine.Table("tuning_run")
+	_, err := session.Insert(value)
+	return err
+}
+
+// UpdateTuningRun method update the tuning run by id
+func UpdateTuningRun(value *TuningRun) error {
+	session := globalEngine.Table("tuning_run")
+	_, err := session.Where("id = ?", value.ID).AllCols().Update(value)
+	return err
+}
+
+// GetLastTuningRun method return the latest tuning run of the project,
+// the runs of feature selection are ignored
+func GetLastTuningRun(project string) (*TuningRun, error) {
+	run := new(TuningRun)
+	session := globalEngine.Table("tuning_run")
+	has, err := session.Where("project = ? and feature_filter = ?", project, false).
+		Desc("id").Limit(1).Get(run)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, nil
+	}
+	return run, nil
+}
+
+// GetJobTuningRun method return the latest tuning run of the job,
+// the runs of feature selection are ignored
+func GetJobTuningRun(jobID string) (*TuningRun, error) {
+	run := new(TuningRun)
+	session := globalEngine.Table("tuning_run")
+	has, err := session.Where("job_id = ? and feature_filter = ?", jobID, false).
+		Desc("id").Limit(1).Get(run)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, nil
+	}
+	return run, nil
+}
+
+// InterruptTuningRuns method mark the running tuning runs as interrupted,
+// it is called when atuned starts, so they are not running any more
+func InterruptTuningRuns() ([]*TuningRun, error) {
+	runs := make([]*TuningRun, 0)
+	session := globalEngine.Table("tuning_run")
+	if err := session.Where("status = ?", TuningRunning).Find(&runs); err != nil {
+		return nil, err
+	}
+
+	for _, run := range runs {
+		run.Status = TuningInterrupted
+		if err := UpdateTuningRun(run); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+// InsertTuningIteration method insert the iteration of the tuning run
+func InsertTuningIteration(value *TuningIteration) error {
+	session := globalEngine.Table("tuning_iteration")
+	_, err := session.Insert(value)
+	return err
+}
+
+// GetTuningIterations method return the iterations of the tuning run
+func GetTuningIterations(runID int64) ([]*TuningIteration, error) {
+	iterations := make([]*TuningIteration, 0)
+	session := globalEngine.Table("tuning_iteration")
+	if err := session.Where("run_id = ?", runID).Asc("iteration", "id").Find(&iterations); err != nil {
+		return nil, err
+	}
+	return iterations, nil
+}
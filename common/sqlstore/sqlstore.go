@@ -27,6 +27,47 @@ import (
 
 var globalEngine *xorm.Engine
 
+// tables of the tuning history, they are created at runtime as well,
+// so the db file shipped by an old version or upgraded by user has them
+var runtimeTables = []string{
+	`CREATE TABLE IF NOT EXISTS tuning_run(
+		id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+		job_id TEXT NOT NULL,
+		project TEXT NOT NULL,
+		engine TEXT NOT NULL,
+		task_id TEXT,
+		feature_filter BOOLEN NOT NULL,
+		max_iterations INTEGER NOT NULL,
+		status TEXT NOT NULL,
+		importance TEXT,
+		start_time DATETIME NOT NULL,
+		end_time DATETIME
+	)`,
+	`CREATE TABLE IF NOT EXISTS tuning_iteration(
+		id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+		run_id INTEGER NOT NULL,
+		iteration INTEGER NOT NULL,
+		params TEXT NOT NULL,
+		applied TEXT,
+		evaluations TEXT NOT NULL,
+		eval_sum REAL NOT NULL,
+		status TEXT,
+		start_time DATETIME,
+		end_time DATETIME,
+		FOREIGN KEY(run_id) REFERENCES tuning_run(id)
+	)`,
+}
+
+// columns added to the tables of the tuning history after they are created,
+// the columns are added to the db file of an old version at runtime
+var runtimeColumns = []struct {
+	table      string
+	column     string
+	definition string
+}{
+	{"tuning_iteration", "applied", "TEXT"},
+}
+
 // Sqlstore : struct for store db engine
 type Sqlstore struct {
 	Cfg    *config.Cfg
@@ -55,7 +96,7 @@ func (s *Sqlstore) Init() error {
 	s.engine = engine
 	globalEngine = engine
 
-	return nil
+	return createRuntimeTables()
 }
 
 // Reload method, reload the db file for hot update
@@ -73,5 +114,40 @@ func Reload(path string) error {
 	}
 
 	globalEngine = engine
+	return createRuntimeTables()
+}
+
+func createRuntimeTables() error {
+	for _, table := range runtimeTables {
+		if _, err := globalEngine.Exec(table); err != nil {
+			return fmt.Errorf("failed to create table: %v", err)
+		}
+	}
+	return addRuntimeColumns()
+}
+
+func addRuntimeColumns() error {
+	for _, item := range runtimeColumns {
+		columns, err := globalEngine.QueryString(fmt.Sprintf("PRAGMA table_info(%s)", item.table))
+		if err != nil {
+			return fmt.Errorf("failed to query table %s: %v", item.table, err)
+		}
+		exist := false
+		for _, column := range columns {
+			if column["name"] == item.column {
+				exist = true
+				break
+			}
+		}
+		if exist {
+			continue
+		}
+		log.Infof("add column %s to table %s", item.column, item.table)
+		_, err = globalEngine.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s",
+			item.table, item.column, item.definition))
+		if err != nil {
+			return fmt.Errorf("failed to add column %s to table %s: %v", item.column, item.table, err)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"fmt"
+	"sync"
+)
+
+// the status of the tuning controlled by user
+const (
+	StatusRunning  = "running"
+	StatusPaused   = "paused"
+	StatusStopping = "stopping"
+)
+
+// the configuration applied when the tuning is stopped
+const (
+	StopRestore = "restore"
+	StopBest    = "best"
+	StopCancel  = "cancel"
+)
+
+// DisconnectKeep keep the current configuration when the client is disconnected
+const DisconnectKeep = "keep"
+
+// StopJob is send to the stop channel when the tuning is stopped by user
+const StopJob = -1
+
+// Control : the pause, resume and stop request of the running tuning,
+// the request takes effect before the optimizer is asked for the next parameters
+type Control struct {
+	mutex  sync.Mutex
+	cond   *sync.Cond
+	paused bool
+	stop   string
+}
+
+// NewControl method create the control of the tuning
+func NewControl() *Control {
+	c := &Control{}
+	c.cond = sync.NewCond(&c.mutex)
+	return c
+}
+
+// Pause method pause the tuning after the running benchmark
+func (c *Control) Pause() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.stop != "" {
+		return fmt.Errorf("the job is stopping")
+	}
+	c.paused = true
+	return nil
+}
+
+// Resume method resume the paused tuning
+func (c *Control) Resume() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.paused {
+		return fmt.Errorf("the job is not paused")
+	}
+	c.paused = false
+	c.cond.Broadcast()
+	return nil
+}
+
+// Stop method stop the tuning and apply the restore or best configuration
+func (c *Control) Stop(action string) error {
+	if action == "" {
+		action = StopRestore
+	}
+	if action != StopRestore && action != StopBest {
+		return fmt.Errorf("stop action must be %s or %s", StopRestore, StopBest)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.stop = action
+	c.cond.Broadcast()
+	return nil
+}
+
+// Cancel method stop the tuning whose client is disconnected
+func (c *Control) Cancel() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.stop == "" {
+		c.stop = StopCancel
+	}
+	c.cond.Broadcast()
+}
+
+// Wait method block while the tuning is paused, return the stop action
+// if the tuning is stopped by user
+func (c *Control) Wait() string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for c.paused && c.stop == "" {
+		c.cond.Wait()
+	}
+	return c.stop
+}
+
+// Status method return the status of the tuning
+func (c *Control) Status() string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	switch {
+	case c.stop != "":
+		return StatusStopping
+	case c.paused:
+		return StatusPaused
+	}
+	return StatusRunning
+}
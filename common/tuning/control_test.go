@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"testing"
+	"time"
+)
+
+func TestControl(t *testing.T) {
+	tests := []struct {
+		name   string
+		do     func(c *Control) error
+		fail   bool
+		status string
+		stop   string
+	}{
+		{"running", func(c *Control) error { return nil }, false, StatusRunning, ""},
+		{"pause", func(c *Control) error { return c.Pause() }, false, StatusPaused, ""},
+		{"resume", func(c *Control) error {
+			if err := c.Pause(); err != nil {
+				return err
+			}
+			return c.Resume()
+		}, false, StatusRunning, ""},
+		{"resume without pause", func(c *Control) error { return c.Resume() }, true, StatusRunning, ""},
+		{"stop by default", func(c *Control) error { return c.Stop("") }, false, StatusStopping, StopRestore},
+		{"stop with the best", func(c *Control) error { return c.Stop(StopBest) }, false, StatusStopping, StopBest},
+		{"stop with unknown action", func(c *Control) error { return c.Stop("keep") }, true, StatusRunning, ""},
+		{"pause while stopping", func(c *Control) error {
+			if err := c.Stop(StopBest); err != nil {
+				return err
+			}
+			return c.Pause()
+		}, true, StatusStopping, StopBest},
+		{"cancel", func(c *Control) error { c.Cancel(); return nil }, false, StatusStopping, StopCancel},
+		{"cancel after stop", func(c *Control) error {
+			if err := c.Stop(StopBest); err != nil {
+				return err
+			}
+			c.Cancel()
+			return nil
+		}, false, StatusStopping, StopBest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewControl()
+			if err := tt.do(c); tt.fail != (err != nil) {
+				t.Errorf("the request returns %v, want failure %v", err, tt.fail)
+			}
+			if status := c.Status(); status != tt.status {
+				t.Errorf("Status = %s, want %s", status, tt.status)
+			}
+			if c.Status() != StatusPaused {
+				if stop := c.Wait(); stop != tt.stop {
+					t.Errorf("Wait = %q, want %q", stop, tt.stop)
+				}
+			}
+		})
+	}
+}
+
+func TestControlWait(t *testing.T) {
+	for _, action := range []string{"resume", StopBest} {
+		c := NewControl()
+		if err := c.Pause(); err != nil {
+			t.Fatal(err)
+		}
+		done := make(chan string)
+		go func() { done <- c.Wait() }()
+		select {
+		case stop := <-done:
+			t.Fatalf("Wait of the paused tuning returns %q", stop)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		want := ""
+		if action == "resume" {
+			_ = c.Resume()
+		} else {
+			_ = c.Stop(action)
+			want = action
+		}
+		select {
+		case stop := <-done:
+			if stop != want {
+				t.Errorf("Wait after %s = %q, want %q", action, stop, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Wait is still blocked after %s", action)
+		}
+	}
+}
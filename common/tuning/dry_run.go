@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"fmt"
+	"strings"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/inventory"
+	"gitee.com/openeuler/A-Tune/common/log"
+)
+
+// DryRun method check the project without changing anything, the get scripts
+// of the knobs are run and the current values are checked with the scopes,
+// the relations and the constraints, an error is returned if any problem is found
+func (o *Optimizer) DryRun(ch chan *PB.TuningMessage) error {
+	display := func(format string, args ...interface{}) {
+		ch <- &PB.TuningMessage{State: PB.TuningMessage_Display, Content: []byte(fmt.Sprintf(format, args...))}
+	}
+
+	inv, err := inventory.Current()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("begin to dry run project %s", o.Prj.Project)
+	problems := 0
+	current := make([]string, 0)
+	display("The knobs of %s:", o.Prj.Project)
+	for _, item := range o.Prj.Object {
+		location := "local"
+		if item.Group != "" {
+			nodes := make([]string, 0, len(item.Clusters))
+			for _, node := range item.Clusters {
+				nodes = append(nodes, node.(string))
+			}
+			location = fmt.Sprintf("group %s on [%s]", item.Group, strings.Join(nodes, ","))
+		}
+		if item.Info.Skip {
+			display("  %s (%s): skipped", item.Name, location)
+			continue
+		}
+		if item.Group != "" && len(item.Clusters) == 0 {
+			display("  %s (%s): problem: no node of the group is assigned", item.Name, location)
+			problems++
+			continue
+		}
+		if strings.TrimSpace(item.Info.SetScript) == "" {
+			display("  %s (%s): problem: the set script is empty", item.Name, location)
+			problems++
+		}
+
+		value, err := o.readKnob(ch, inv, item)
+		if err != nil {
+			display("  %s (%s): problem: failed to get the value: %v", item.Name, location, err)
+			problems++
+			continue
+		}
+		display("  %s (%s): %s", item.Name, location, value)
+		current = append(current, item.Name+"="+value)
+		// the current value is the baseline and the value restored after the
+		// tuning, it is never set by the optimizer, so it is only a warning
+		if !item.Info.InRange(value) {
+			display("    warning: the current value is out of the scope or options of %s, "+
+				"it is kept as the baseline but never searched", item.Name)
+		}
+	}
+
+	relations := o.Prj.CheckRelations()
+	for _, problem := range relations {
+		display("  problem: %s", problem)
+	}
+	problems += len(relations)
+	if len(relations) == 0 && !o.Prj.MatchRelations(strings.Join(current, ",")) {
+		display("  problem: the current values do not match the relations or the constraints")
+		problems++
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("found %d problems in project %s", problems, o.Prj.Project)
+	}
+	message := fmt.Sprintf("the %d knobs of project %s are checked, nothing is changed",
+		len(o.Prj.Object), o.Prj.Project)
+	log.Info(message)
+	ch <- &PB.TuningMessage{State: PB.TuningMessage_Ending, Content: []byte(message)}
+	return nil
+}
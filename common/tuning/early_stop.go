@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+)
+
+// earlyStop method return the reason if the tuning should be ended before
+// all the iterations are run, the rules are max_duration, target_improvement
+// and no improvement better than plateau_improvement in plateau_iters
+func (o *Optimizer) earlyStop() string {
+	if o.Iter == 0 {
+		return ""
+	}
+
+	if o.MaxDuration > 0 && !o.StartTime.IsZero() && time.Since(o.StartTime) >= o.MaxDuration {
+		return fmt.Sprintf("the tuning time %s exceeds max_duration %s",
+			time.Since(o.StartTime).Round(time.Second), o.MaxDuration)
+	}
+
+	if o.FeatureFilter {
+		return ""
+	}
+
+	if o.TargetImprovement > 0 {
+		rate := o.improveRate(o.BaseEvalSum, o.MinEvalSum)
+		if rate >= o.TargetImprovement {
+			return fmt.Sprintf("the performance improvement rate %.2f%% reaches target_improvement %.2f%%",
+				rate, o.TargetImprovement)
+		}
+	}
+
+	iters := int(o.PlateauIters)
+	if iters > 0 && len(o.BestEvalSums) > iters {
+		last := len(o.BestEvalSums) - 1
+		rate := o.improveRate(o.BestEvalSums[last-iters], o.BestEvalSums[last])
+		if rate <= o.PlateauImprovement {
+			return fmt.Sprintf("the performance improvement rate %.2f%% of the last %d iterations "+
+				"is not better than plateau_improvement %.2f%%", rate, iters, o.PlateauImprovement)
+		}
+	}
+	return ""
+}
+
+// improveRate method return the improvement rate in percent from the base
+// evaluation sum to the current one as the client shows, the sum of multiple
+// objectives is already the improvement rate against the baseline
+func (o *Optimizer) improveRate(base float64, current float64) float64 {
+	if len(o.Objectives) > 1 {
+		return base - current
+	}
+	if base < 0 {
+		return (current - base) / base * 100
+	}
+	if current == 0 {
+		// the rate is relative to the base as the client shows
+		if base == 0 {
+			return 0
+		}
+		return 100
+	}
+	return (base - current) / math.Abs(current) * 100
+}
+
+// stopEarly method apply the best params found so far and end the tuning
+func (o *Optimizer) stopEarly(ch chan *PB.TuningMessage, stopCh chan int, reason string) error {
+	message := fmt.Sprintf("\n The tuning is ended early after %d iterations, because %s.\n"+
+		" The final optimization result is: %s\n"+
+		" The final evaluation value is: %s\n", o.Iter, reason, o.BestParams,
+		DisplayEval(o.FinalEval)) + o.ParetoMessage()
+	return o.endTuning(ch, stopCh, o.BestParams, sqlstore.TuningFinished, message)
+}
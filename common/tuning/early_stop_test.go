@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEarlyStop(t *testing.T) {
+	tests := []struct {
+		name   string
+		o      Optimizer
+		reason string
+	}{
+		{"baseline", Optimizer{Iter: 0, MaxDuration: time.Nanosecond, StartTime: time.Now().Add(-time.Hour)}, ""},
+		{"no rules", Optimizer{Iter: 5, BaseEvalSum: -100, MinEvalSum: -200}, ""},
+		{"max duration", Optimizer{Iter: 1, MaxDuration: time.Minute, StartTime: time.Now().Add(-time.Hour)},
+			"max_duration"},
+		{"within max duration", Optimizer{Iter: 1, MaxDuration: time.Hour, StartTime: time.Now()}, ""},
+		{"target of throughput", Optimizer{Iter: 3, BaseEvalSum: -100, MinEvalSum: -120, TargetImprovement: 20},
+			"target_improvement"},
+		{"below the target", Optimizer{Iter: 3, BaseEvalSum: -100, MinEvalSum: -110, TargetImprovement: 20}, ""},
+		{"target of latency", Optimizer{Iter: 3, BaseEvalSum: 10, MinEvalSum: 8, TargetImprovement: 25},
+			"target_improvement"},
+		{"target of objectives", Optimizer{Iter: 3, Objectives: []string{"a", "b"}, BaseEvalSum: 0,
+			MinEvalSum: -30, TargetImprovement: 25}, "target_improvement"},
+		{"target of the feature filter", Optimizer{Iter: 3, FeatureFilter: true, BaseEvalSum: -100,
+			MinEvalSum: -200, TargetImprovement: 20}, ""},
+		{"plateau", Optimizer{Iter: 4, PlateauIters: 2, PlateauImprovement: 1,
+			BestEvalSums: []float64{-100, -120, -120, -120.5}}, "plateau_improvement"},
+		{"improving", Optimizer{Iter: 4, PlateauIters: 2, PlateauImprovement: 1,
+			BestEvalSums: []float64{-100, -110, -120, -130}}, ""},
+		{"too few iterations", Optimizer{Iter: 2, PlateauIters: 2, BestEvalSums: []float64{-100, -100}}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := tt.o.earlyStop()
+			if tt.reason == "" && reason != "" || !strings.Contains(reason, tt.reason) {
+				t.Errorf("earlyStop = %q, want the reason of %q", reason, tt.reason)
+			}
+		})
+	}
+}
+
+func TestImproveRate(t *testing.T) {
+	tests := []struct {
+		base    float64
+		current float64
+		want    float64
+	}{
+		{-100, -150, 50},
+		{-100, -50, -50},
+		{10, 5, 100},
+		{10, 20, -50},
+		{10, 0, 100},
+		{0, 0, 0},
+		{0, 5, -100},
+	}
+	o := &Optimizer{}
+	for _, tt := range tests {
+		if got := o.improveRate(tt.base, tt.current); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("improveRate(%v, %v) = %v, want %v", tt.base, tt.current, got, tt.want)
+		}
+	}
+}
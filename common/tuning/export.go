@@ -0,0 +1,168 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+const scriptSection = "script"
+
+// profileSections : the sections of the profile for the items of tuned_item,
+// the knobs of the other items are exported to the script section
+var profileSections = []struct {
+	item    string
+	section string
+}{
+	{"Sysctl", "sysctl"},
+	{"Sysfs", "sysfs"},
+	{"Systemctl", "systemctl"},
+	{"Ulimit", "ulimit"},
+	{"Bootloader", "bootloader.grub2"},
+}
+
+func profileSection(knob string) string {
+	item, err := sqlstore.GetPropertyItem(knob)
+	if err != nil {
+		return scriptSection
+	}
+	for _, section := range profileSections {
+		if section.item == item {
+			return section.section
+		}
+	}
+	return scriptSection
+}
+
+// ExportProfile method return the profile of the best params of the last
+// tuning, which can be defined as service-app-scenario
+func (o *Optimizer) ExportProfile(name string) (string, error) {
+	iter, params, err := o.bestIteration()
+	if err != nil {
+		return "", err
+	}
+
+	scripts := make(map[string]string)
+	for _, obj := range o.Prj.Object {
+		scripts[obj.Name] = obj.Info.SetScript
+	}
+
+	sections := make(map[string][]string)
+	for _, para := range strings.Split(params, ",") {
+		kvs := strings.SplitN(para, "=", 2)
+		if len(kvs) != 2 {
+			continue
+		}
+		knob, value := strings.TrimSpace(kvs[0]), strings.TrimSpace(kvs[1])
+		if value == models.InactiveValue {
+			continue
+		}
+		section := profileSection(knob)
+		if section == scriptSection {
+			sections[section] = append(sections[section],
+				fmt.Sprintf("# set: %s", strings.Replace(scripts[knob], "\n", " ", -1)))
+		}
+		sections[section] = append(sections[section], fmt.Sprintf("%s = %s", knob, value))
+	}
+
+	var content strings.Builder
+	content.WriteString(fmt.Sprintf("#\n# %s A-Tune configuration\n", strings.Replace(name, "-", " ", -1)))
+	content.WriteString(fmt.Sprintf("# exported from the iteration %d of the tuning of %s at %s\n#\n",
+		iter, o.Prj.Project, time.Now().Format(config.DefaultTimeFormat)))
+	content.WriteString("[main]\n")
+	sectionNames := make([]string, 0, len(profileSections)+1)
+	for _, section := range profileSections {
+		sectionNames = append(sectionNames, section.section)
+	}
+	for _, section := range append(sectionNames, scriptSection) {
+		if len(sections[section]) == 0 {
+			continue
+		}
+		content.WriteString(fmt.Sprintf("\n[%s]\n", section))
+		content.WriteString(strings.Join(sections[section], "\n") + "\n")
+	}
+	log.Infof("export the params of iteration %d of %s to profile %s", iter, o.Prj.Project, name)
+	return content.String(), nil
+}
+
+// bestIteration method return the best feasible iteration of the last
+// tuning, the tuning log is used if the history is not in the database
+func (o *Optimizer) bestIteration() (int, string, error) {
+	run, err := sqlstore.GetLastTuningRun(o.Prj.Project)
+	if err != nil {
+		return 0, "", err
+	}
+	if run != nil {
+		iterations, err := sqlstore.GetTuningIterations(run.ID)
+		if err != nil {
+			return 0, "", err
+		}
+		var best *sqlstore.TuningIteration
+		for _, iteration := range iterations {
+			if iteration.Iteration == 0 || iteration.Status != "" {
+				continue
+			}
+			if best == nil || iteration.EvalSum < best.EvalSum {
+				best = iteration
+			}
+		}
+		if best == nil {
+			return 0, "", fmt.Errorf("no successful iteration in the last tuning of %s", o.Prj.Project)
+		}
+		return best.Iteration, best.Params, nil
+	}
+
+	tuningFile := path.Join(config.DefaultTuningLogPath, fmt.Sprintf("%s_%s", o.Prj.Project, config.TuningFile))
+	file, err := os.Open(tuningFile)
+	if err != nil {
+		return 0, "", err
+	}
+	defer file.Close()
+
+	bestIter, bestParams, bestSum := 0, "", 0.0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		items := strings.Split(scanner.Text(), "|")
+		if len(items) != 6 {
+			continue
+		}
+		iter, err := strconv.Atoi(items[0])
+		if err != nil || iter == 0 {
+			continue
+		}
+		sum, err := utils.CalculateBenchMark(items[3])
+		if err != nil {
+			continue
+		}
+		if bestParams == "" || sum < bestSum {
+			bestIter, bestParams, bestSum = iter, items[5], sum
+		}
+	}
+	if bestParams == "" {
+		return 0, "", fmt.Errorf("no iteration is found in %s", tuningFile)
+	}
+	return bestIter, bestParams, nil
+}
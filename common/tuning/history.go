@@ -0,0 +1,254 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+)
+
+// readTuningHistory method read the history of the interrupted run or the
+// last run from the database, the text tuning log is used if the project
+// has no run in it
+func (o *Optimizer) readTuningHistory(body *models.OptimizerPostBody) error {
+	run := o.interrupted
+	if run == nil {
+		var err error
+		if run, err = sqlstore.GetLastTuningRun(o.Prj.Project); err != nil {
+			return err
+		}
+	}
+	if run == nil {
+		log.Infof("project %s has no tuning run in database, read the tuning log", o.Prj.Project)
+		return o.readTuningLog(body)
+	}
+
+	iterations, err := sqlstore.GetTuningIterations(run.ID)
+	if err != nil {
+		return err
+	}
+	log.Infof("continue the %s tuning run %d of job %s with %d iterations",
+		run.Status, run.ID, run.JobID, len(iterations))
+
+	xrefMap := make(map[int][]string)
+	yrefMap := make(map[int]float64)
+	evalArray := make(map[int]string)
+	paramsArray := make(map[int]string)
+	failed := make(map[int]bool)
+	for index, iteration := range iterations {
+		if index > 0 && iteration.Iteration <= iterations[index-1].Iteration {
+			return fmt.Errorf("iteration %d of tuning run %d is recorded more than once",
+				iteration.Iteration, run.ID)
+		}
+		o.Iter = iteration.Iteration
+		if o.Iter == 0 {
+			o.EvalBase = iteration.Evaluations
+			o.BaseEvalSum = iteration.EvalSum
+			o.MinEvalSum = iteration.EvalSum
+			o.EvalMinArray = iteration.Evaluations
+			continue
+		}
+
+		o.TotalTime = o.TotalTime + iteration.EndTime.Sub(iteration.StartTime).Seconds()
+		if iteration.Status == project.IterationSkipped {
+			continue
+		}
+		evalArray[o.Iter] = iteration.Evaluations
+		paramsArray[o.Iter] = iteration.Params
+		failed[o.Iter] = iteration.Status != ""
+		if !failed[o.Iter] {
+			o.updatePareto(o.Iter, iteration.Evaluations, iteration.Params)
+		}
+		xValue := make([]string, 0)
+		for _, para := range strings.Split(iteration.Params, ",") {
+			if !o.active(strings.Split(para, "=")[0]) {
+				continue
+			}
+			xValue = append(xValue, o.Prj.EncodeParams(para))
+		}
+		xrefMap[o.Iter] = xValue
+		yrefMap[o.Iter] = iteration.EvalSum
+	}
+
+	for i := 1; i <= o.Iter; i++ {
+		if _, ok := xrefMap[i]; !ok {
+			continue
+		}
+		if yrefMap[i] < o.MinEvalSum && !failed[i] {
+			o.MinEvalSum = yrefMap[i]
+			o.EvalMinArray = evalArray[i]
+			o.BestParams = paramsArray[i]
+		}
+		body.Xref = append(body.Xref, xrefMap[i])
+		body.Yref = append(body.Yref, strconv.FormatFloat(yrefMap[i], 'f', -1, 64))
+	}
+
+	o.FinalEval = o.EvalMinArray
+	o.Run = run
+	return nil
+}
+
+// ResumeInterrupted method continue the tuning run interrupted by the restart
+// of atuned, the params before the tuning are kept to be restored
+func (o *Optimizer) ResumeInterrupted(run *sqlstore.TuningRun) error {
+	restoreConf, err := ioutil.ReadFile(path.Join(config.DefaultTuningLogPath,
+		o.Prj.Project+config.TuningRestoreConfig))
+	if err != nil {
+		return err
+	}
+	o.InitConfig = string(restoreConf)
+	o.BackupFlag = true
+	o.Restart = true
+	o.PrjId = run.JobID
+	o.interrupted = run
+	return nil
+}
+
+// ApplyIteration method apply the params of the iteration of the last
+// tuning run, such as one of the Pareto-optimal configurations
+func (o *Optimizer) ApplyIteration(ch chan *PB.TuningMessage, iter int) error {
+	params, err := o.iterationParams(iter)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+
+	log.Infof("applying the params of iteration %d: %s", iter, params)
+	if err := o.applyParams(params); err != nil {
+		return err
+	}
+
+	result := fmt.Sprintf("apply the params of iteration %d of %s project success: %s",
+		iter, o.Prj.Project, params)
+	ch <- &PB.TuningMessage{State: PB.TuningMessage_Ending, Content: []byte(result)}
+	log.Infof(result)
+	return nil
+}
+
+func (o *Optimizer) iterationParams(iter int) (string, error) {
+	run, err := sqlstore.GetLastTuningRun(o.Prj.Project)
+	if err != nil {
+		return "", err
+	}
+	if run != nil {
+		iterations, err := sqlstore.GetTuningIterations(run.ID)
+		if err != nil {
+			return "", err
+		}
+		for _, iteration := range iterations {
+			if iteration.Iteration == iter {
+				return iteration.Params, nil
+			}
+		}
+		return "", fmt.Errorf("iteration %d is not found in the last tuning of %s", iter, o.Prj.Project)
+	}
+
+	tuningFile := path.Join(config.DefaultTuningLogPath, fmt.Sprintf("%s_%s", o.Prj.Project, config.TuningFile))
+	file, err := os.Open(tuningFile)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	params := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		items := strings.Split(scanner.Text(), "|")
+		if len(items) == 6 && items[0] == strconv.Itoa(iter) {
+			params = items[5]
+		}
+	}
+	if params == "" {
+		return "", fmt.Errorf("iteration %d is not found in %s", iter, tuningFile)
+	}
+	return params, nil
+}
+
+// startRun method record the tuning run of the created optimizer task,
+// the run of the restarted tuning is continued
+func (o *Optimizer) startRun(engine string, iters int32, taskID string) {
+	run := o.Run
+	if run == nil || !o.Restart || o.FeatureFilter {
+		run = &sqlstore.TuningRun{Project: o.Prj.Project, StartTime: time.Now()}
+	}
+	run.JobID = o.PrjId
+	run.Engine = engine
+	run.TaskID = taskID
+	run.FeatureFilter = o.FeatureFilter
+	run.MaxIterations = iters
+	run.Status = sqlstore.TuningRunning
+
+	var err error
+	if run.ID == 0 {
+		err = sqlstore.InsertTuningRun(run)
+	} else {
+		err = sqlstore.UpdateTuningRun(run)
+	}
+	if err != nil {
+		log.Errorf("failed to record the tuning run of %s: %v", o.Prj.Project, err)
+		o.Run = nil
+		return
+	}
+	o.Run = run
+}
+
+// endRun method change the status of the running run
+func (o *Optimizer) endRun(status string) {
+	if o.Run == nil || o.Run.Status != sqlstore.TuningRunning {
+		return
+	}
+	o.Run.Status = status
+	o.Run.EndTime = time.Now()
+	if o.RespPutIns != nil && o.RespPutIns.Rank != "" {
+		o.Run.Importance = o.RespPutIns.Rank
+	}
+	if err := sqlstore.UpdateTuningRun(o.Run); err != nil {
+		log.Errorf("failed to update the tuning run %d: %v", o.Run.ID, err)
+	}
+}
+
+// recordIteration method save the iteration to the running run
+func (o *Optimizer) recordIteration(startTime string, endTime string, eval string,
+	params string, evalSum float64) {
+	if o.Run == nil || o.Run.Status != sqlstore.TuningRunning {
+		return
+	}
+	iteration := &sqlstore.TuningIteration{
+		RunID:       o.Run.ID,
+		Iteration:   o.Iter,
+		Params:      params,
+		Applied:     o.AppliedParams,
+		Evaluations: eval,
+		EvalSum:     evalSum,
+		Status:      o.IterStatus,
+	}
+	iteration.StartTime, _ = time.ParseInLocation(config.DefaultTimeFormat, startTime, time.Local)
+	iteration.EndTime, _ = time.ParseInLocation(config.DefaultTimeFormat, endTime, time.Local)
+	if err := sqlstore.InsertTuningIteration(iteration); err != nil {
+		log.Errorf("failed to record the iteration %d of %s: %v", o.Iter, o.Prj.Project, err)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"testing"
+	"time"
+
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/optimizer"
+	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+)
+
+type testIteration struct {
+	iter    int
+	params  string
+	evalSum float64
+	status  string
+}
+
+func insertIterations(t *testing.T, runID int64, iterations []testIteration) {
+	now := time.Now()
+	for _, iteration := range iterations {
+		err := sqlstore.InsertTuningIteration(&sqlstore.TuningIteration{RunID: runID,
+			Iteration: iteration.iter, Params: iteration.params, Evaluations: "tps=-10",
+			EvalSum: iteration.evalSum, Status: iteration.status, StartTime: now, EndTime: now.Add(time.Second)})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadTuningHistory(t *testing.T) {
+	tests := []struct {
+		name       string
+		iterations []testIteration
+		fail       bool
+		xref       int
+		iter       int
+		min        float64
+	}{
+		{"benchmarked", []testIteration{{0, "a=1", -10, ""}, {1, "a=2", -12, ""}, {2, "a=3", -11, ""}},
+			false, 2, 2, -12},
+		{"failed iterations", []testIteration{{0, "a=1", -10, ""}, {1, "a=2", -20, project.IterationPenalized},
+			{2, "a=3", -20, project.IterationInfeasible}, {3, "a=4", -11, ""}}, false, 3, 3, -11},
+		{"skipped iterations", []testIteration{{0, "a=1", -10, ""}, {1, "a=2", 0, project.IterationSkipped},
+			{2, "a=3", -11, ""}}, false, 1, 2, -11},
+		{"duplicate iterations", []testIteration{{0, "a=1", -10, ""}, {1, "a=2", -12, ""},
+			{1, "a=3", -13, project.IterationInfeasible}}, true, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newTestOptimizer(t)
+			insertIterations(t, o.Run.ID, tt.iterations)
+			o.endRun(sqlstore.TuningInterrupted)
+
+			body := new(models.OptimizerPostBody)
+			err := o.readTuningHistory(body)
+			if tt.fail {
+				if err == nil {
+					t.Errorf("readTuningHistory succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readTuningHistory failed: %v", err)
+			}
+			if len(body.Xref) != tt.xref || len(body.Yref) != tt.xref {
+				t.Errorf("the history has %d params and %d values, want %d", len(body.Xref), len(body.Yref), tt.xref)
+			}
+			if o.Iter != tt.iter || o.MinEvalSum != tt.min {
+				t.Errorf("the history ends at iteration %d with the minimum %v, want %d and %v",
+					o.Iter, o.MinEvalSum, tt.iter, tt.min)
+			}
+		})
+	}
+}
+
+func TestReadInterruptedHistory(t *testing.T) {
+	o := newTestOptimizer(t)
+	interrupted := o.Run
+	insertIterations(t, interrupted.ID, []testIteration{{0, "a=1", -10, ""}, {1, "a=2", -12, ""}})
+	o.endRun(sqlstore.TuningInterrupted)
+
+	o.Run = nil
+	o.startRun(optimizer.BayesName, 10, "")
+	insertIterations(t, o.Run.ID, []testIteration{{0, "a=1", -10, ""}})
+	o.endRun(sqlstore.TuningFinished)
+
+	o.interrupted = interrupted
+	body := new(models.OptimizerPostBody)
+	if err := o.readTuningHistory(body); err != nil {
+		t.Fatalf("readTuningHistory failed: %v", err)
+	}
+	if o.Run.ID != interrupted.ID || o.Iter != 1 || len(body.Xref) != 1 {
+		t.Errorf("the history is read from run %d at iteration %d, want run %d at iteration 1",
+			o.Run.ID, o.Iter, interrupted.ID)
+	}
+}
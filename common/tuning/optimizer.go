@@ -24,19 +24,20 @@ import (
 	"os"
 	"path"
 	"path/filepath"
-	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	PB "gitee.com/openeuler/A-Tune/api/profile"
-	"gitee.com/openeuler/A-Tune/common/client"
 	"gitee.com/openeuler/A-Tune/common/config"
-	"gitee.com/openeuler/A-Tune/common/http"
+	"gitee.com/openeuler/A-Tune/common/inventory"
 	"gitee.com/openeuler/A-Tune/common/log"
 	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/optimizer"
 	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
 	"gitee.com/openeuler/A-Tune/common/utils"
 )
 
@@ -51,18 +52,36 @@ type Optimizer struct {
 	SplitCount          int32
 	EvalFluctuation     float64
 	RandomStarts        int32
-	OptimizerPutURL     string
+	EngineIns           optimizer.Engine
+	Run                 *sqlstore.TuningRun
+	Control             *Control
 	FinalEval           string
+	BestParams          string
 	Engine              string
 	FeatureFilterEngine string
 	TuningFile          string
 	Evaluations         string
+	IterStatus          string
+	ObjectiveMode       string
+	Objectives          []string
+	Pareto              []*ParetoPoint
 	MinEvalSum          float64
+	BaseEvalSum         float64
+	BestEvalSums        []float64
+	StartTime           time.Time
+	MaxDuration         time.Duration
+	TargetImprovement   float64
+	PlateauIters        int32
+	PlateauImprovement  float64
+	WarmStart           string
+	WarmStartData       []byte
+	WarmStartWeight     float64
 	EvalMinArray        string
 	EvalBase            string
 	RespPutIns          *models.RespPutBody
 	StartIterTime       string
 	InitConfig          string
+	AppliedParams       string
 	TotalTime           float64
 	Percentage          float64
 	BackupFlag          bool
@@ -72,6 +91,8 @@ type Optimizer struct {
 	EvalStatistics      []float64
 	FeatureSelector     string
 	PrjId               string
+	resume              *RebootState
+	interrupted         *sqlstore.TuningRun
 }
 
 // object set type
@@ -91,7 +112,7 @@ func (o *Optimizer) InitTuned(ch chan *PB.TuningMessage, stopCh chan int) error
 
 	//dynamic profle setting
 	if o.Prj.Maxiterations == 0 {
-		log.Error("project:%s max iterations is 0", o.Prj.Project)
+		log.Errorf("project:%s max iterations is 0", o.Prj.Project)
 		ch <- &PB.TuningMessage{State: PB.TuningMessage_Display, Content: []byte(fmt.Sprintf("project:%s max iterations is 0\n",
 			o.Prj.Project))}
 		return fmt.Errorf("max iterations cannot be 0")
@@ -123,6 +144,9 @@ func (o *Optimizer) InitTuned(ch chan *PB.TuningMessage, stopCh chan int) error
 			return err
 		}
 	}
+	if o.resume != nil {
+		return o.benchmarkResumed(ch)
+	}
 	if err := o.createOptimizerTask(ch, o.MaxIter, o.Engine); err != nil {
 		return err
 	}
@@ -149,14 +173,18 @@ func (o *Optimizer) createOptimizerTask(ch chan *PB.TuningMessage, iters int32,
 
 	optimizerBody := new(models.OptimizerPostBody)
 	if o.Restart {
-		if err := o.readTuningLog(optimizerBody); err != nil {
+		if err := o.readTuningHistory(optimizerBody); err != nil {
 			return err
 		}
 	}
 	if o.Restart && iters <= int32(len(optimizerBody.Xref)) && (engine != "gridsearch") && (engine != "abtest") {
 		return fmt.Errorf("create task failed for client ask iters less than tuning history")
 	}
-	optimizerBody.MaxEval = iters
+	seeds, err := o.addWarmStart(optimizerBody)
+	if err != nil {
+		return err
+	}
+	optimizerBody.MaxEval = iters + int32(seeds)
 	optimizerBody.Engine = engine
 	optimizerBody.RandomStarts = o.RandomStarts
 	optimizerBody.FeatureFilter = o.FeatureFilter
@@ -167,6 +195,11 @@ func (o *Optimizer) createOptimizerTask(ch chan *PB.TuningMessage, iters int32,
 	optimizerBody.Knobs = make([]models.Knob, 0)
 	optimizerBody.PrjName = o.Prj.Project
 	defaultValues := strings.Split(o.InitConfig, ",")
+	objs := make(map[string]*project.YamlPrjObj, len(o.Prj.Object))
+	for _, item := range o.Prj.Object {
+		objs[item.Name] = item
+	}
+	conditions := o.Prj.Conditions()
 	for i, item := range o.Prj.Object {
 		if item.Info.Skip {
 			continue
@@ -180,15 +213,29 @@ func (o *Optimizer) createOptimizerTask(ch chan *PB.TuningMessage, iters int32,
 		} else {
 			knob.Ref = item.Info.Ref
 		}
-		knob.Range = item.Info.Scope
-		knob.Items = item.Info.Items
-		knob.Step = item.Info.Step
+		knob.Range = project.Floats(item.Info.Scope)
+		knob.Items = project.Floats(item.Info.Items)
+		knob.Step = float64(item.Info.Step)
 		knob.Options = item.Info.Options
+		if condition, ok := conditions[item.Name]; ok {
+			knob.Parent, knob.Condition = condition[0], condition[1:]
+		}
+		if parent, ok := objs[knob.Parent]; ok && parent.Info.Transformed() {
+			for index, value := range knob.Condition {
+				knob.Condition[index] = parent.Info.Encode(value)
+			}
+		}
+		if item.Info.Transformed() {
+			knob.Type, knob.Dtype, knob.Range, knob.Step = item.Info.SearchSpace()
+			knob.Items = nil
+			knob.Ref = item.Info.Encode(knob.Ref)
+		}
 		optimizerBody.Knobs = append(optimizerBody.Knobs, *knob)
 	}
 
 	log.Infof("optimizer post body is: %+v", optimizerBody)
-	respPostIns, err := optimizerBody.Post()
+	o.EngineIns = optimizer.New(engine)
+	respPostIns, err := o.EngineIns.Post(optimizerBody)
 	if err != nil {
 		return err
 	}
@@ -198,11 +245,12 @@ func (o *Optimizer) createOptimizerTask(ch chan *PB.TuningMessage, iters int32,
 		log.Errorf(err.Error())
 		return err
 	}
+	o.startRun(engine, int32(respPostIns.Iters-seeds), respPostIns.TaskID)
 
 	ch <- &PB.TuningMessage{
 		State:         PB.TuningMessage_JobInit,
 		FeatureFilter: o.FeatureFilter,
-		Content:       []byte(strconv.Itoa(respPostIns.Iters)),
+		Content:       []byte(strconv.Itoa(respPostIns.Iters - seeds)),
 		TuningLog: &PB.TuningHistory{
 			BaseEval:  o.EvalBase,
 			MinEval:   o.EvalMinArray,
@@ -211,10 +259,6 @@ func (o *Optimizer) createOptimizerTask(ch chan *PB.TuningMessage, iters int32,
 			Starts:    int32(o.Iter) + 1,
 		},
 	}
-	url := config.GetURL(config.OptimizerURI)
-	o.OptimizerPutURL = fmt.Sprintf("%s/%s", url, respPostIns.TaskID)
-	log.Infof("optimizer put url is: %s", o.OptimizerPutURL)
-
 	optPutBody := new(models.OptimizerPutBody)
 	optPutBody.Iterations = -1
 	optPutBody.Value = ""
@@ -222,7 +266,7 @@ func (o *Optimizer) createOptimizerTask(ch chan *PB.TuningMessage, iters int32,
 	optPutBody.PrjName = o.Prj.Project + "-" + o.PrjId
 	optPutBody.MaxIter = respPostIns.Iters
 	log.Infof("optimizer put body is: %+v", optPutBody)
-	_, err = optPutBody.Put(o.OptimizerPutURL)
+	_, err = o.EngineIns.Put(optPutBody)
 	if err != nil {
 		log.Errorf("get setting parameter error: %v", err)
 		return err
@@ -245,10 +289,14 @@ func (o *Optimizer) readTuningLog(body *models.OptimizerPostBody) error {
 	xrefMap := make(map[int][]string)
 	yrefMap := make(map[int]float64)
 	evalArray := make(map[int]string)
+	paramsArray := make(map[int]string)
 	for scanner.Scan() {
 		line := scanner.Text()
 		items := strings.Split(line, "|")
 		if len(items) != 6 {
+			if len(items) > 1 {
+				log.Warnf("skip the invalid line of %s: %s", o.TuningFile, line)
+			}
 			continue
 		}
 		if o.Iter, err = strconv.Atoi(items[0]); err != nil {
@@ -262,6 +310,7 @@ func (o *Optimizer) readTuningLog(body *models.OptimizerPostBody) error {
 
 		if o.Iter == 0 {
 			o.EvalBase = items[4]
+			o.BaseEvalSum = yFloat
 			o.MinEvalSum = yFloat
 			o.EvalMinArray = items[4]
 			continue
@@ -277,13 +326,15 @@ func (o *Optimizer) readTuningLog(body *models.OptimizerPostBody) error {
 		o.TotalTime = o.TotalTime + endTime.Sub(startTime).Seconds()
 
 		evalArray[o.Iter] = items[4]
+		paramsArray[o.Iter] = items[5]
+		o.updatePareto(o.Iter, items[4], items[5])
 		xPara := strings.Split(items[5], ",")
 		xValue := make([]string, 0)
 		for _, para := range xPara {
 			if !o.active(strings.Split(para, "=")[0]) {
 				continue
 			}
-			xValue = append(xValue, para)
+			xValue = append(xValue, o.Prj.EncodeParams(para))
 		}
 
 		xrefMap[o.Iter] = xValue
@@ -291,9 +342,13 @@ func (o *Optimizer) readTuningLog(body *models.OptimizerPostBody) error {
 	}
 
 	for i := 1; i <= o.Iter; i++ {
+		if _, ok := xrefMap[i]; !ok {
+			continue
+		}
 		if yrefMap[i] < o.MinEvalSum {
 			o.MinEvalSum = yrefMap[i]
 			o.EvalMinArray = evalArray[i]
+			o.BestParams = paramsArray[i]
 		}
 		body.Xref = append(body.Xref, xrefMap[i])
 		body.Yref = append(body.Yref, strconv.FormatFloat(yrefMap[i], 'f', -1, 64))
@@ -336,47 +391,83 @@ func (o *Optimizer) DynamicTuned(ch chan *PB.TuningMessage, stopCh chan int) err
 		return err
 	}
 
+	if o.Control != nil {
+		action := o.Control.Wait()
+		if action == StopCancel {
+			return fmt.Errorf("tuning of %s is cancelled", o.Prj.Project)
+		}
+		if action != "" {
+			return o.terminate(ch, stopCh, action)
+		}
+	}
+
+	if reason := o.earlyStop(); reason != "" {
+		return o.stopEarly(ch, stopCh, reason)
+	}
+
+	// the iteration before reboot is benchmarked without the optimizer task,
+	// which is created with the history including it
+	if o.EngineIns == nil && o.Restart {
+		if err = o.createOptimizerTask(ch, o.MaxIter, o.Engine); err != nil {
+			return err
+		}
+		lines, evalValue = "", ""
+	}
+
 	optPutStartTime := time.Now()
 
 	optPutBody := new(models.OptimizerPutBody)
 	optPutBody.Iterations = o.Iter
 	optPutBody.Value = evalValue
 	optPutBody.Line = lines
+	if o.IterStatus == project.IterationSkipped && evalValue != "" {
+		optPutBody.Value = ""
+		optPutBody.Skip = true
+	}
 	optPutBody.PrjName = o.Prj.Project + "-" + o.PrjId
 	optPutBody.MaxIter = int(o.MaxIter)
 	log.Infof("optimizer put body is: %+v", optPutBody)
-	o.RespPutIns, err = optPutBody.Put(o.OptimizerPutURL)
+	o.RespPutIns, err = o.EngineIns.Put(optPutBody)
 	if err != nil {
 		log.Errorf("get setting parameter error: %v", err)
 		return err
 	}
 
 	log.Infof("optimizer put response body: %+v", o.RespPutIns)
+	o.RespPutIns.Param = o.Prj.MarkInactive(o.Prj.DecodeParams(o.RespPutIns.Param))
 
+	o.AppliedParams = ""
 	if !o.matchRelations(o.RespPutIns.Param) && !o.RespPutIns.Finished {
-		ch <- &PB.TuningMessage{State: PB.TuningMessage_Threshold}
-		return nil
+		return o.failIteration(ch, &PB.TuningMessage{State: PB.TuningMessage_Threshold})
 	}
 
-	err, scripts := o.Prj.RunSet(o.RespPutIns.Param)
+	err, knobs := o.Prj.RunSet(o.RespPutIns.Param)
+	if err == nil {
+		log.Info("set the parameter success")
+		err = o.syncConfigToOthers(knobs)
+	}
+	if applyErr, ok := err.(*project.ApplyError); ok && !o.RespPutIns.Finished {
+		log.Warnf("the params of iteration %d failed to apply: %v", o.Iter+1, applyErr)
+		return o.failIteration(ch, &PB.TuningMessage{State: PB.TuningMessage_ApplyFailed,
+			Content: []byte(applyErr.Error())})
+	}
 	if err != nil {
 		log.Error(err)
 		return err
 	}
-	log.Info("set the parameter success")
+	o.AppliedParams = o.verifyParams(ch)
 
-	err = o.syncConfigToOthers(scripts)
-	if err != nil {
-		return err
+	if knobs := o.Prj.RebootKnobs(); len(knobs) > 0 && !o.RespPutIns.Finished {
+		return o.reboot(ch, stopCh, knobs)
 	}
 
-	err, scripts = o.Prj.RestartProject()
+	err, knobs = o.Prj.RestartProject()
 	if err != nil {
 		log.Error(err)
 		return err
 	}
 	log.Info("restart project success")
-	err = o.syncConfigToOthers(scripts)
+	err = o.syncActionsToOthers(knobs)
 	if err != nil {
 		return err
 	}
@@ -391,7 +482,7 @@ func (o *Optimizer) DynamicTuned(ch chan *PB.TuningMessage, stopCh chan int) err
 		}
 
 		if !o.FeatureFilter {
-			finalEval := strings.Replace(o.FinalEval, "=-", "=", -1)
+			finalEval := DisplayEval(o.FinalEval)
 			message = fmt.Sprintf("\n The final optimization result is: %s\n"+
 				" The final evaluation value is: %s\n", o.RespPutIns.Param, finalEval)
 			if o.RespPutIns.Rank != "" {
@@ -427,8 +518,9 @@ func (o *Optimizer) DynamicTuned(ch chan *PB.TuningMessage, stopCh chan int) err
 		} else {
 			stopCh <- 1
 		}
+		o.endRun(sqlstore.TuningFinished)
 		o.Iter = 0
-		if err = deleteTask(o.OptimizerPutURL); err != nil {
+		if err = o.DeleteTask(); err != nil {
 			return err
 		}
 		return nil
@@ -443,10 +535,107 @@ func (o *Optimizer) DynamicTuned(ch chan *PB.TuningMessage, stopCh chan int) err
 	return nil
 }
 
+// failIteration method start the next iteration whose params are not
+// benchmarked, such as the params violating the relations or failing to
+// apply, the client counts it as an iteration too, so its evaluation is
+// recorded under a new number
+func (o *Optimizer) failIteration(ch chan *PB.TuningMessage, message *PB.TuningMessage) error {
+	o.Iter++
+	o.StartIterTime = time.Now().Format(config.DefaultTimeFormat)
+	ch <- message
+	return nil
+}
+
+// terminate method stop the tuning by user, apply the restore or the best
+// configuration and delete the optimizer task
+func (o *Optimizer) terminate(ch chan *PB.TuningMessage, stopCh chan int, action string) error {
+	params := o.BestParams
+	if action == StopRestore || params == "" {
+		action = StopRestore
+		tuningRestoreConf := path.Join(config.DefaultTuningLogPath, o.Prj.Project+config.TuningRestoreConfig)
+		content, err := ioutil.ReadFile(tuningRestoreConf)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		params = string(content)
+	}
+
+	log.Infof("tuning is stopped by user, apply the %s params: %s", action, params)
+	message := fmt.Sprintf("\n The tuning is stopped by user after %d iterations.\n"+
+		" The %s configuration is applied: %s\n", o.Iter, action, params)
+	if action == StopBest {
+		message = message + fmt.Sprintf(" The evaluation value is: %s\n", DisplayEval(o.FinalEval))
+	}
+	message = message + o.ParetoMessage()
+	return o.endTuning(ch, stopCh, params, sqlstore.TuningStopped, message)
+}
+
+// applyParams method set the params and restart the project on all the nodes
+func (o *Optimizer) applyParams(params string) error {
+	err, knobs := o.Prj.RunSet(params)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	if err = o.syncConfigToOthers(knobs); err != nil {
+		return err
+	}
+
+	err, knobs = o.Prj.RestartProject()
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	return o.syncActionsToOthers(knobs)
+}
+
+// endTuning method apply the params, delete the optimizer task and
+// end the tuning before the optimizer finished
+func (o *Optimizer) endTuning(ch chan *PB.TuningMessage, stopCh chan int, params string,
+	status string, message string) error {
+	if params != "" {
+		if err := o.applyParams(params); err != nil {
+			return err
+		}
+	}
+
+	o.endRun(status)
+	if err := o.DeleteTask(); err != nil {
+		log.Errorf("delete the optimizer task of %s failed: %v", o.Prj.Project, err)
+	}
+
+	log.Info(message)
+	ch <- &PB.TuningMessage{State: PB.TuningMessage_Ending, Content: []byte(message)}
+	stopCh <- StopJob
+	return nil
+}
+
 func (o *Optimizer) matchRelations(optStr string) bool {
 	return o.Prj.MatchRelations(optStr)
 }
 
+// DisplayEval return the evaluations in the display format, the minimized
+// values are shown as they are measured and rounded to two decimals
+func DisplayEval(eval string) string {
+	if eval == "" {
+		return eval
+	}
+	items := strings.Split(eval, ",")
+	for i, item := range items {
+		kvs := strings.SplitN(item, "=", 2)
+		if len(kvs) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(kvs[1]), 64)
+		if err != nil {
+			continue
+		}
+		items[i] = fmt.Sprintf("%s=%.2f", kvs[0], math.Abs(value))
+	}
+	return strings.Join(items, ",")
+}
+
 func (o *Optimizer) filterParams() (string, error) {
 	log.Infof("params importance weight is: %s", o.RespPutIns.Rank)
 	if strings.TrimSpace(o.RespPutIns.Rank) == "" {
@@ -478,7 +667,11 @@ func (o *Optimizer) filterParams() (string, error) {
 	mean := utils.Mean(o.EvalStatistics)
 	sd := utils.StandardDeviation(o.EvalStatistics)
 	log.Infof("Eval statistics: %v, mean: %v, sd: %v", o.EvalStatistics, mean, sd)
-	if sd/math.Abs(mean) < o.EvalFluctuation {
+	fluctuation := o.EvalFluctuation
+	if fluctuation == 0 {
+		fluctuation = config.FeatureFluctuation
+	}
+	if sd/math.Abs(mean) < fluctuation {
 		skipIndex = 0
 	}
 
@@ -523,13 +716,13 @@ func (o *Optimizer) RestoreConfigTuned(ch chan *PB.TuningMessage) error {
 	}
 
 	log.Infof("restoring params is: %s", string(content))
-	err, scripts := o.Prj.RunSet(string(content))
+	err, knobs := o.Prj.RunSet(string(content))
 	if err != nil {
 		log.Error(err)
 		return err
 	}
 
-	if err := o.syncConfigToOthers(scripts); err != nil {
+	if err := o.syncConfigToOthers(knobs); err != nil {
 		return err
 	}
 
@@ -539,6 +732,45 @@ func (o *Optimizer) RestoreConfigTuned(ch chan *PB.TuningMessage) error {
 	return nil
 }
 
+// Disconnect method apply the disconnect policy to the running tuning
+// whose client is disconnected
+func (o *Optimizer) Disconnect(ch chan *PB.TuningMessage) {
+	if o.Prj == nil || o.EngineIns == nil {
+		return
+	}
+
+	policy := config.DisconnectPolicy
+	if o.Prj.DisconnectPolicy != "" {
+		policy = o.Prj.DisconnectPolicy
+	}
+	log.Warnf("tuning client of %s is disconnected at iteration %d, the disconnect policy is %s",
+		o.Prj.Project, o.Iter, policy)
+
+	switch policy {
+	case DisconnectKeep:
+		log.Warnf("keep the current params of %s", o.Prj.Project)
+		return
+	case StopBest:
+		if o.BestParams != "" {
+			log.Infof("applying the best params of %s: %s", o.Prj.Project, o.BestParams)
+			if err := o.applyParams(o.BestParams); err != nil {
+				log.Errorf("apply the best params of %s failed: %v", o.Prj.Project, err)
+				return
+			}
+			log.Infof("apply the best params of %s success", o.Prj.Project)
+			return
+		}
+		log.Warnf("%s has no best params yet, restore the params instead", o.Prj.Project)
+	case StopRestore:
+	default:
+		log.Warnf("unknown disconnect policy %s of %s, restore the params instead", policy, o.Prj.Project)
+	}
+
+	if err := o.RestoreConfigTuned(ch); err != nil {
+		log.Errorf("restore the params of %s failed: %v", o.Prj.Project, err)
+	}
+}
+
 func (o *Optimizer) evalParsing(ch chan *PB.TuningMessage) (string, string, error) {
 	if o.Restart && o.Content == nil {
 		return "", "", nil
@@ -558,11 +790,15 @@ func (o *Optimizer) evalParsing(ch chan *PB.TuningMessage) (string, string, erro
 	iterInfo = append(iterInfo, strconv.Itoa(o.Iter), o.StartIterTime, endIterTime,
 		o.Evaluations, eval, configs)
 	output := strings.Join(iterInfo, "|")
-	err := utils.WriteFile(o.TuningFile, output+"\n", utils.FilePerm,
-		os.O_APPEND|os.O_WRONLY)
-	if err != nil {
-		log.Error(err)
-		return "", "", err
+	// the skipped iteration is not told to the optimizer, so it is not in
+	// the tuning log which is the history of the optimizer
+	if o.IterStatus != project.IterationSkipped {
+		err := utils.WriteFile(o.TuningFile, output+"\n", utils.FilePerm,
+			os.O_APPEND|os.O_WRONLY)
+		if err != nil {
+			log.Error(err)
+			return "", "", err
+		}
 	}
 
 	kvs := strings.Split(o.Evaluations, "=")
@@ -575,9 +811,31 @@ func (o *Optimizer) evalParsing(ch chan *PB.TuningMessage) (string, string, erro
 		return "", "", err
 	}
 
-	if o.Iter == 1 || evalSum < o.MinEvalSum {
+	o.recordIteration(o.StartIterTime, endIterTime, eval, configs, evalSum)
+	if o.IterStatus != "" {
+		message := fmt.Sprintf("the benchmark of iteration %d failed, the iteration is %s",
+			o.Iter, o.IterStatus)
+		if o.IterStatus == project.IterationInfeasible {
+			message = fmt.Sprintf("iteration %d is infeasible, its params or evaluations "+
+				"violate the relations or the constraints", o.Iter)
+		}
+		log.Warn(message)
+		ch <- &PB.TuningMessage{State: PB.TuningMessage_Detail, Content: []byte(message)}
+	}
+
+	if o.Iter == 0 {
+		o.BaseEvalSum = evalSum
+	}
+	if o.IterStatus == "" && (o.Iter <= 1 || evalSum < o.MinEvalSum) {
 		o.MinEvalSum = evalSum
 		o.FinalEval = eval
+		o.BestParams = configs
+	}
+	if o.Iter != 0 && !o.FeatureFilter {
+		o.BestEvalSums = append(o.BestEvalSums, o.MinEvalSum)
+	}
+	if o.IterStatus == "" && !o.FeatureFilter {
+		o.updatePareto(o.Iter, eval, configs)
 	}
 
 	if o.FeatureFilter && o.Iter != 0 {
@@ -587,16 +845,6 @@ func (o *Optimizer) evalParsing(ch chan *PB.TuningMessage) (string, string, erro
 	return output, kvs[1], nil
 }
 
-func deleteTask(url string) error {
-	resp, err := http.Delete(url)
-	if err != nil {
-		log.Error("delete task failed:", err)
-		return err
-	}
-	resp.Body.Close()
-	return nil
-}
-
 // CheckServerPrj: check server prj
 func CheckServerPrj(data string, optimizer *Optimizer) error {
 	projects := strings.Split(data, ",")
@@ -607,21 +855,7 @@ func CheckServerPrj(data string, optimizer *Optimizer) error {
 		exceptProject[strings.TrimSpace(projectStr)] = struct{}{}
 	}
 
-	var prjs []*project.YamlPrjSvr
-	var yamlPaths []string
-	err := filepath.Walk(config.DefaultTuningPath, func(path string, info os.FileInfo, err error) error {
-		if !info.IsDir() {
-			prj := new(project.YamlPrjSvr)
-			if err := utils.ParseFile(path, "yaml", &prj); err != nil {
-				return fmt.Errorf("load %s failed, err: %v", path, err)
-			}
-			log.Infof("project:%s load %s success", prj.Project, path)
-			prjs = append(prjs, prj)
-			yamlPaths = append(yamlPaths, path)
-		}
-		return nil
-	})
-
+	prjs, yamlPaths, err := loadProjects()
 	if err != nil {
 		return err
 	}
@@ -632,12 +866,20 @@ func CheckServerPrj(data string, optimizer *Optimizer) error {
 		}
 
 		log.Infof("find Project:%s from %s", prj.Project, yamlPaths[idx])
+		if err := prj.CheckTransforms(); err != nil {
+			return fmt.Errorf("load %s failed, err: %v", yamlPaths[idx], err)
+		}
 
 		objectSet := new(ObjectSet)
 		objectSet.Objects = append(objectSet.Objects, prj.Object...)
 		prj.Object = CheckObjectReplace(prj.Object)
-		objectSet.CheckObjectDuplicate()
+		if err := objectSet.CheckObjectDuplicate(); err != nil {
+			return err
+		}
 		prj.Object = objectSet.Objects
+		for _, obj := range prj.Object {
+			obj.Project = prj.Project
+		}
 
 		if optimizer.Prj == nil {
 			optimizer.Prj = prj
@@ -649,12 +891,50 @@ func CheckServerPrj(data string, optimizer *Optimizer) error {
 	if optimizer.Prj == nil {
 		return fmt.Errorf("project:%s not found", data)
 	}
+	if err := optimizer.Prj.CheckConstraints(); err != nil {
+		return fmt.Errorf("load project:%s failed, err: %v", data, err)
+	}
 
 	log.Debugf("optimizer objects: %+v", optimizer.Prj)
 	optimizer.Percentage = config.Percent
 	return nil
 }
 
+// loadProjects load all the project yaml files in the tuning path
+func loadProjects() ([]*project.YamlPrjSvr, []string, error) {
+	var prjs []*project.YamlPrjSvr
+	var yamlPaths []string
+	err := filepath.Walk(config.DefaultTuningPath, func(path string, info os.FileInfo, err error) error {
+		if !info.IsDir() {
+			prj := new(project.YamlPrjSvr)
+			if err := utils.ParseFile(path, "yaml", &prj); err != nil {
+				return fmt.Errorf("load %s failed, err: %v", path, err)
+			}
+			log.Infof("project:%s load %s success", prj.Project, path)
+			prjs = append(prjs, prj)
+			yamlPaths = append(yamlPaths, path)
+		}
+		return nil
+	})
+	return prjs, yamlPaths, err
+}
+
+// loadNodeProject load the project yaml of this node, which the knobs sent
+// by the other nodes are resolved to
+func loadNodeProject(name string) (*project.YamlPrjSvr, error) {
+	prjs, _, err := loadProjects()
+	if err != nil {
+		return nil, err
+	}
+	for _, prj := range prjs {
+		if prj.Project == name {
+			prj.Object = CheckObjectReplace(prj.Object)
+			return prj, nil
+		}
+	}
+	return nil, fmt.Errorf("project:%s not found", name)
+}
+
 // Check if object contains {disk} or {network}
 func CheckObjectReplace(objects []*project.YamlPrjObj) []*project.YamlPrjObj {
 	for ind := 0; ind < len(objects); ind++ {
@@ -692,95 +972,102 @@ func ReplaceObject(replace string, param string, objects []*project.YamlPrjObj,
 	return objects
 }
 
-// Check the address value in atuned.cnf
-func (obj *ObjectSet) CheckObjectDuplicate() {
-	if strings.TrimSpace(config.Connect) == "" {
-		return
+// CheckObjectDuplicate method copy the objects for the groups of the cluster
+// inventory, the copy of the group is named object-group
+func (obj *ObjectSet) CheckObjectDuplicate() error {
+	inv, err := inventory.Current()
+	if err != nil {
+		return err
 	}
-	var ips []string
-	var ipGroups [][]string
-	ips = strings.Split(strings.TrimSpace(config.Connect), "-")
-	for i := 0; i < len(ips); i++ {
-		ipSameGroup := strings.Split(strings.TrimSpace(ips[i]), ",")
-		ipGroups = append(ipGroups, ipSameGroup)
+	if inv == nil {
+		return nil
 	}
-	objLen := len(obj.Objects)
-	for ind := 0; ind < objLen; ind++ {
-		if obj.Objects[ind].Clusters != nil {
+
+	appended := make([]*project.YamlPrjObj, 0)
+	for ind, object := range obj.Objects {
+		if object.Group != "" {
 			continue
 		}
-		obj.WriteObject(ind, ipGroups)
-	}
-}
-
-func (obj *ObjectSet) WriteObject(ind int, groups [][]string) {
-	var exceptsIps []interface{}
-	exceptsIp := strings.Split(strings.TrimSpace(obj.Objects[ind].Info.Except), ",")
-	for _, exceptIp := range exceptsIp {
-		exceptsIps = append(exceptsIps, exceptIp)
-	}
-	if len(groups) == 1 {
-		obj.Objects[ind].Name = obj.Objects[ind].Name + "-0"
-		for i := 0; i < len(groups[0]); i++ {
-			if !utils.InArray(exceptsIps, groups[0][i]) {
-				obj.Objects[ind].Clusters = append(obj.Objects[ind].Clusters, groups[0][i])
-			}
+		groups := inv.Match(object.Groups)
+		if len(groups) == 0 {
+			return fmt.Errorf("no group of %s is in the inventory: %v", object.Name, object.Groups)
 		}
-		return
-	}
-	obj.AppendObject(ind, groups)
-	obj.Objects[ind].Name = obj.Objects[ind].Name + "-0"
-	for i := 0; i < len(groups[0]); i++ {
-		if !utils.InArray(exceptsIps, groups[0][i]) {
-			obj.Objects[ind].Clusters = append(obj.Objects[ind].Clusters, groups[0][i])
+		for i, group := range groups {
+			newObj := &project.YamlPrjObj{
+				Name:      object.Name + "-" + group.Name,
+				Info:      object.Info,
+				Relations: object.Relations,
+				Groups:    object.Groups,
+				Group:     group.Name,
+				Clusters:  groupClusters(group, object.Info.Except),
+			}
+			if i == 0 {
+				obj.Objects[ind] = newObj
+				continue
+			}
+			appended = append(appended, newObj)
 		}
 	}
+	obj.Objects = append(obj.Objects, appended...)
+	return nil
 }
 
-// append new params in object
-func (obj *ObjectSet) AppendObject(ind int, ipGroups [][]string) {
-	var exceptsIps []interface{}
-	exceptsIp := strings.Split(strings.TrimSpace(obj.Objects[ind].Info.Except), ",")
-	for _, exceptIp := range exceptsIp {
-		exceptsIps = append(exceptsIps, exceptIp)
-	}
-	for i := 1; i < len(ipGroups); i++ {
-		if len(ipGroups[i]) == 0 {
-			continue
+// groupClusters return the addresses of the nodes of the group, except the
+// addresses in except
+func groupClusters(group *inventory.Group, except string) []interface{} {
+	var excepts []interface{}
+	for _, address := range strings.Split(strings.TrimSpace(except), ",") {
+		excepts = append(excepts, strings.TrimSpace(address))
+	}
+	clusters := make([]interface{}, 0)
+	for _, address := range group.Addresses() {
+		if !utils.InArray(excepts, address) {
+			clusters = append(clusters, address)
 		}
-		newObj := &project.YamlPrjObj{
-			Name:      obj.Objects[ind].Name,
-			Info:      obj.Objects[ind].Info,
-			Relations: obj.Objects[ind].Relations,
-		}
-		for j := 0; j < len(ipGroups[i]); j++ {
-			if !utils.InArray(exceptsIps, ipGroups[i][j]) {
-				newObj.Clusters = append(newObj.Clusters, ipGroups[i][j])
-			}
-		}
-		newObj.Name = newObj.Name + "-" + strconv.Itoa(i)
-		obj.Objects = append(obj.Objects, newObj)
 	}
+	return clusters
 }
 
-// SyncTune: sync tuned node
+// SyncTunedNode method set the knobs sent by the other node, the knobs are
+// resolved to the scripts of the project yaml of this node, and the knobs
+// already set are reverted in reverse order if any of them failed
 func (o *Optimizer) SyncTunedNode(ch chan *PB.TuningMessage) error {
 	log.Infof("setting params is: %s", string(o.Content))
-	var commands []string
-	err := json.Unmarshal([]byte(string(o.Content)), &commands)
-	if err != nil {
-		fmt.Errorf("invalid setting params! err: %v", err)
-		return err
+	var knobs []*project.KnobValue
+	if err := json.Unmarshal(o.Content, &knobs); err != nil {
+		return fmt.Errorf("invalid setting params! err: %v", err)
 	}
 
-	for _, command := range commands {
-		log.Infof("execute setting command: %s", string(command))
-		if command == "no operation" {
-			continue
+	prjs := make(map[string]*project.YamlPrjSvr)
+	priors := make([]*project.KnobValue, 0)
+	for _, knob := range knobs {
+		if knob.Action == project.KnobGet {
+			return fmt.Errorf("invalid action %s of knob %s", knob.Action, knob.Name)
 		}
-		_, err := project.ExecCommand(command)
+		prj, ok := prjs[knob.Project]
+		if !ok {
+			var err error
+			if prj, err = loadNodeProject(knob.Project); err != nil {
+				return revertNodeKnobs(prjs, priors, err)
+			}
+			prjs[knob.Project] = prj
+		}
+		scripts, err := prj.NodeScripts(knob)
 		if err != nil {
-			return fmt.Errorf("failed to exec %s, err: %v", command, err)
+			return revertNodeKnobs(prjs, priors, err)
+		}
+		if knob.Action == project.KnobSet {
+			prior, err := nodeKnobValue(prj, knob)
+			if err != nil {
+				return revertNodeKnobs(prjs, priors, err)
+			}
+			priors = append(priors, prior)
+		}
+		for _, script := range scripts {
+			log.Infof("execute setting command: %s", script)
+			if _, err := project.ExecCommand(script); err != nil {
+				return revertNodeKnobs(prjs, priors, fmt.Errorf("failed to exec %s, err: %v", script, err))
+			}
 		}
 	}
 
@@ -790,15 +1077,66 @@ func (o *Optimizer) SyncTunedNode(ch chan *PB.TuningMessage) error {
 	return nil
 }
 
-func (o *Optimizer) GetNodeInitialConfig(ch chan *PB.TuningMessage) error {
+// nodeKnobValue return the current value of the knob on this node, which
+// the knob is reverted to if the sync failed
+func nodeKnobValue(prj *project.YamlPrjSvr, knob *project.KnobValue) (*project.KnobValue, error) {
+	get := *knob
+	get.Action = project.KnobGet
+	scripts, err := prj.NodeScripts(&get)
+	if err != nil {
+		return nil, err
+	}
+	out, err := project.ExecGetOutput(scripts[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to exec %s, err: %v", scripts[0], err)
+	}
+	prior := *knob
+	prior.Value = strings.TrimSpace(string(out))
+	return &prior, nil
+}
 
+// revertNodeKnobs set the prior values of the knobs in reverse order after
+// the sync failed
+func revertNodeKnobs(prjs map[string]*project.YamlPrjSvr, priors []*project.KnobValue, cause error) error {
+	log.Errorf("%v, revert the knobs of this node", cause)
+	for i := len(priors) - 1; i >= 0; i-- {
+		scripts, err := prjs[priors[i].Project].NodeScripts(priors[i])
+		if err != nil {
+			log.Errorf("failed to revert %s, err: %v", priors[i].Name, err)
+			continue
+		}
+		log.Infof("revert %s: %s", priors[i].Name, scripts[0])
+		if _, err := project.ExecCommand(scripts[0]); err != nil {
+			log.Errorf("failed to revert %s, err: %v", priors[i].Name, err)
+		}
+	}
+	return cause
+}
+
+// GetNodeInitialConfig method get the value of the knob sent by the other
+// node by the get script of the project yaml of this node
+func (o *Optimizer) GetNodeInitialConfig(ch chan *PB.TuningMessage) error {
 	log.Infof("start to get the initial config: %s", string(o.Content))
-	command := string(o.Content)
+	knob := new(project.KnobValue)
+	if err := json.Unmarshal(o.Content, knob); err != nil {
+		return fmt.Errorf("invalid knob! err: %v", err)
+	}
+	if knob.Action != project.KnobGet {
+		return fmt.Errorf("invalid action %s of knob %s", knob.Action, knob.Name)
+	}
+	prj, err := loadNodeProject(knob.Project)
+	if err != nil {
+		return err
+	}
+	scripts, err := prj.NodeScripts(knob)
+	if err != nil {
+		return err
+	}
 
-	log.Infof("execute getting command: %s", command)
-	out, err := project.ExecGetOutput(command)
+	log.Infof("execute getting command: %s", scripts[0])
+	out, err := project.ExecGetOutput(scripts[0])
 	if err != nil {
-		return fmt.Errorf("failed to exec %s, err: %v", command, err)
+		return fmt.Errorf("failed to exec %s, err: %v", scripts[0], err)
 	}
 	output := string(out)
 
@@ -808,63 +1146,96 @@ func (o *Optimizer) GetNodeInitialConfig(ch chan *PB.TuningMessage) error {
 	return nil
 }
 
-//sync config to other nodes in cluster mode
-func (o *Optimizer) syncConfigToOthers(scripts []string) error {
-	if config.TransProtocol != "tcp" || scripts == nil {
+// syncConfigToOthers method sync the knobs to the other nodes in
+// cluster mode, the nodes already changed are rolled back if any node failed,
+// and the ApplyError is returned if all of them are rolled back
+func (o *Optimizer) syncConfigToOthers(knobs project.NodeKnobs) error {
+	if config.TransProtocol != "tcp" || len(knobs) == 0 {
 		return nil
 	}
 
-	var ips []string
-	var ipGroups [][]string
-	ips = strings.Split(strings.TrimSpace(config.Connect), "-")
-	for i := 0; i < len(ips); i++ {
-		ipSameGroup := strings.Split(strings.TrimSpace(ips[i]), ",")
-		ipGroups = append(ipGroups, ipSameGroup)
+	failed := o.syncNodes(knobs)
+	if len(failed) == 0 {
+		return nil
 	}
-	log.Infof("sync other nodes: %v", ipGroups)
 
-	if len(scripts) == 0 {
-		log.Infof("no scripts")
-		return nil
+	nodes := make([]string, 0, len(failed))
+	for address, err := range failed {
+		log.Errorf("server %s failed to sync config, err: %v", address, err)
+		nodes = append(nodes, address)
 	}
+	sort.Strings(nodes)
 
-	objNum := len(scripts) / len(ipGroups)
-	for i := 0; i < len(ipGroups); i++ {
-		for j := 0; j < len(ipGroups[i]); j++ {
-			if ipGroups[i][j] == config.Address || ipGroups[i][j] == "" {
-				continue
-			}
-			var newScripts []string
-			if i == 0 {
-				for objIndex := 0; objIndex < objNum; objIndex++ {
-					if utils.InArray(o.Prj.Object[objIndex].Clusters, ipGroups[i][j]) {
-						newScripts = append(newScripts, scripts[objIndex])
-					} else {
-						newScripts = append(newScripts, "no operation")
-					}
-				}
-			} else {
-				for objIndex := 0; objIndex < objNum; objIndex++ {
-					scriptIndex := objNum + objIndex*(len(ipGroups)-1) + i - 1
-					if utils.InArray(o.Prj.Object[scriptIndex].Clusters, ipGroups[i][j]) {
-						newScripts = append(newScripts, scripts[scriptIndex])
-					} else {
-						newScripts = append(newScripts, "no operation")
-					}
-				}
-			}
-			if err := o.syncConfigToNode(ipGroups[i][j], newScripts); err != nil {
-				log.Errorf("server %s failed to sync config, err: %v", ipGroups[i][j], err)
-				return err
-			}
+	syncErr := fmt.Errorf("failed to sync config to %s", strings.Join(nodes, ","))
+	err, rollback := o.Prj.Rollback()
+	if err != nil {
+		log.Errorf("failed to roll back the config of %s: %v", o.Prj.Project, err)
+		return syncErr
+	}
+	changed := make(project.NodeKnobs)
+	for address := range knobs {
+		if _, ok := failed[address]; !ok {
+			changed[address] = rollback[address]
 		}
 	}
+	rollbackFailed := o.syncNodes(changed)
+	for address, err := range rollbackFailed {
+		log.Errorf("server %s failed to roll back config, err: %v", address, err)
+	}
+	if len(rollbackFailed) > 0 {
+		return syncErr
+	}
+	return &project.ApplyError{Err: syncErr}
+}
+
+// syncActionsToOthers method run the actions such as restarting the workload
+// on the other nodes in cluster mode
+func (o *Optimizer) syncActionsToOthers(knobs project.NodeKnobs) error {
+	if config.TransProtocol != "tcp" || len(knobs) == 0 {
+		return nil
+	}
+
+	for address, err := range o.syncNodes(knobs) {
+		log.Errorf("server %s failed to restart or reload, err: %v", address, err)
+		return err
+	}
 	return nil
 }
 
+// syncNodes method send the knobs to the nodes in parallel, and return
+// the errors of the failed nodes
+func (o *Optimizer) syncNodes(knobs project.NodeKnobs) map[string]error {
+	inv, err := inventory.Current()
+	failed := make(map[string]error)
+	if err != nil {
+		for address := range knobs {
+			failed[address] = err
+		}
+		return failed
+	}
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for address, nodeKnobs := range knobs {
+		if len(nodeKnobs) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(node *inventory.Node, nodeKnobs []*project.KnobValue) {
+			defer wg.Done()
+			if err := o.syncConfigToNode(node, nodeKnobs); err != nil {
+				lock.Lock()
+				failed[node.Address] = err
+				lock.Unlock()
+			}
+		}(inv.Node(address), nodeKnobs)
+	}
+	wg.Wait()
+	return failed
+}
+
 //sync config to server node
-func (o *Optimizer) syncConfigToNode(server string, scripts []string) error {
-	c, err := client.NewClient(server, config.Port)
+func (o *Optimizer) syncConfigToNode(node *inventory.Node, knobs []*project.KnobValue) error {
+	c, err := node.Connect()
 	if err != nil {
 		return err
 	}
@@ -882,8 +1253,9 @@ func (o *Optimizer) syncConfigToNode(server string, scripts []string) error {
 		}
 	}()
 
-	scriptsJson, _ := json.Marshal(scripts)
-	content := &PB.TuningMessage{State: PB.TuningMessage_SyncConfig, Content: []byte(scriptsJson)}
+	knobsJson, _ := json.Marshal(knobs)
+	log.Infof("sync config to %s: %s", node.Address, string(knobsJson))
+	content := &PB.TuningMessage{State: PB.TuningMessage_SyncConfig, Content: knobsJson}
 	if err := stream.Send(content); err != nil {
 		return fmt.Errorf("sends failure, error: %v", err)
 	}
@@ -900,7 +1272,7 @@ func (o *Optimizer) syncConfigToNode(server string, scripts []string) error {
 
 		state := reply.GetState()
 		if state == PB.TuningMessage_Ending {
-			log.Infof("server %s reply status success", server)
+			log.Infof("server %s reply status success", node.Address)
 			break
 		}
 	}
@@ -930,50 +1302,19 @@ func (o *Optimizer) InitFeatureSel(ch chan *PB.TuningMessage, stopCh chan int) e
 func (o *Optimizer) Backup(ch chan *PB.TuningMessage) error {
 	o.BackupFlag = true
 	initConfigure := make([]string, 0)
-	var ips []string
-	var ipGroups [][]string
-	ips = strings.Split(strings.TrimSpace(config.Connect), "-")
-	for i := 0; i < len(ips); i++ {
-		ipSameGroup := strings.Split(strings.TrimSpace(ips[i]), ",")
-		ipGroups = append(ipGroups, ipSameGroup)
-	}
-	var objGroupStr string
+	inv, err := inventory.Current()
+	if err != nil {
+		return err
+	}
 	for _, item := range o.Prj.Object {
-		objGroupStr = ""
-		reg := regexp.MustCompile(`-[0-9]+$`)
-		res := reg.FindAllString(item.Name, -1)
-		resLen := len(res)
-		if resLen == 0 {
-			out, err := project.ExecGetOutput(item.Info.GetScript)
-			if err != nil {
-				return fmt.Errorf("failed to exec %s, err: %v", item.Info.GetScript, err)
-			}
-			initConfigure = append(initConfigure, strings.TrimSpace(item.Name+"="+strings.TrimSpace(string(out))))
-		} else {
-			for wordPos := 1; wordPos < len(res[0]); wordPos++ {
-				objGroupStr += string(res[0][wordPos])
-			}
-			objGroup, err := strconv.Atoi(string(objGroupStr))
-			if err != nil {
-				return err
-			}
-			if objGroup == 0 {
-				out, err := project.ExecGetOutput(item.Info.GetScript)
-				if err != nil {
-					return fmt.Errorf("failed to exec %s, err: %v", item.Info.GetScript, err)
-				}
-				initConfigure = append(initConfigure, strings.TrimSpace(item.Name+"="+strings.TrimSpace(string(out))))
-			} else {
-				result, err := o.ExecGetCommand(ch, ipGroups[objGroup][0], config.Port, item.Info.GetScript)
-				if err != nil {
-					return err
-				}
-				initConfigure = append(initConfigure, strings.TrimSpace(item.Name+"="+strings.TrimSpace(string(result))))
-			}
+		value, err := o.readKnob(ch, inv, item)
+		if err != nil {
+			return err
 		}
+		initConfigure = append(initConfigure, strings.TrimSpace(item.Name+"="+value))
 	}
 
-	err := utils.WriteFile(path.Join(config.DefaultTuningLogPath,
+	err = utils.WriteFile(path.Join(config.DefaultTuningLogPath,
 		o.Prj.Project+config.TuningRestoreConfig), strings.Join(initConfigure, ","),
 		utils.FilePerm, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
 	if err != nil {
@@ -981,11 +1322,65 @@ func (o *Optimizer) Backup(ch chan *PB.TuningMessage) error {
 		return err
 	}
 	o.InitConfig = strings.Join(initConfigure, ",")
+	o.Prj.SetApplied(o.InitConfig)
 	return nil
 }
 
-func (o *Optimizer) ExecGetCommand(ch chan *PB.TuningMessage, ip string, port string, script string) (string, error) {
-	c, err := client.NewClient(ip, port)
+// readKnob method return the current value of the knob, which is read on
+// the first node of its cluster if it is not set on the local node
+func (o *Optimizer) readKnob(ch chan *PB.TuningMessage, inv *inventory.Inventory,
+	item *project.YamlPrjObj) (string, error) {
+	if item.Local() || len(item.Clusters) == 0 {
+		out, err := project.ExecGetOutput(item.Info.GetScript)
+		if err != nil {
+			return "", fmt.Errorf("failed to exec %s, err: %v", item.Info.GetScript, err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+	result, err := o.ExecGetCommand(ch, inv.Node(item.Clusters[0].(string)), item)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(result), nil
+}
+
+// verifyParams method read back the knobs set in the iteration, and report
+// the knobs whose values are not applied as requested, such as the values
+// clamped by the kernel, the values read back are returned
+func (o *Optimizer) verifyParams(ch chan *PB.TuningMessage) string {
+	inv, err := inventory.Current()
+	if err != nil {
+		log.Warnf("failed to verify the params of iteration %d: %v", o.Iter, err)
+		return ""
+	}
+	applied := make([]string, 0)
+	for _, item := range o.Prj.Object {
+		requested, ok := o.Prj.Requested(item.Name)
+		if !ok || item.Info.Needreboot == "true" {
+			continue
+		}
+		actual, err := o.readKnob(ch, inv, item)
+		if err != nil {
+			log.Warnf("failed to read back %s: %v", item.Name, err)
+			continue
+		}
+		applied = append(applied, item.Name+"="+actual)
+		if project.MatchValue(requested, actual, config.VerifyTolerance) {
+			continue
+		}
+		message := fmt.Sprintf("the value of %s is %s after it is set to %s in iteration %d",
+			item.Name, actual, requested, o.Iter)
+		log.Warn(message)
+		ch <- &PB.TuningMessage{State: PB.TuningMessage_Detail, Content: []byte(message)}
+	}
+	return strings.Join(applied, ",")
+}
+
+// ExecGetCommand method get the value of the knob on the node, the node runs
+// the get script of its own project yaml
+func (o *Optimizer) ExecGetCommand(ch chan *PB.TuningMessage, node *inventory.Node,
+	item *project.YamlPrjObj) (string, error) {
+	c, err := node.Connect()
 	if err != nil {
 		return "", err
 	}
@@ -1003,7 +1398,13 @@ func (o *Optimizer) ExecGetCommand(ch chan *PB.TuningMessage, ip string, port st
 		}
 	}()
 
-	content := &PB.TuningMessage{State: PB.TuningMessage_GetInitialConfig, Content: []byte(script)}
+	knobJson, _ := json.Marshal(&project.KnobValue{
+		Action:  project.KnobGet,
+		Project: item.Project,
+		Name:    item.Name,
+		Group:   item.Group,
+	})
+	content := &PB.TuningMessage{State: PB.TuningMessage_GetInitialConfig, Content: knobJson}
 	if err := stream.Send(content); err != nil {
 		return "", fmt.Errorf("sends failure, error: %v", err)
 	}
@@ -1021,7 +1422,7 @@ func (o *Optimizer) ExecGetCommand(ch chan *PB.TuningMessage, ip string, port st
 		state := reply.GetState()
 		result := reply.GetInitialConfig()
 		if state == PB.TuningMessage_Ending {
-			log.Infof("server %s get initial config success", ip)
+			log.Infof("server %s get initial config success", node.Address)
 			return string(result), nil
 		}
 	}
@@ -1030,14 +1431,14 @@ func (o *Optimizer) ExecGetCommand(ch chan *PB.TuningMessage, ip string, port st
 
 // DeleteTask method delete the optimizer task in runing
 func (o *Optimizer) DeleteTask() error {
-	if o.OptimizerPutURL == "" {
+	o.endRun(sqlstore.TuningInterrupted)
+	if o.EngineIns == nil {
 		return nil
 	}
 
-	if err := deleteTask(o.OptimizerPutURL); err != nil {
+	if err := o.EngineIns.Delete(); err != nil {
 		return err
 	}
-	log.Infof("delete task %s success!", o.OptimizerPutURL)
-	o.OptimizerPutURL = ""
+	o.EngineIns = nil
 	return nil
 }
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/optimizer"
+	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+)
+
+// newTestOptimizer return the optimizer of a project with one knob, whose
+// tuning log and database are in a temporary directory
+func newTestOptimizer(t *testing.T) *Optimizer {
+	dir, err := ioutil.TempDir("", "tuning")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	if err := sqlstore.Reload(path.Join(dir, "atuned.db")); err != nil {
+		t.Fatal(err)
+	}
+	// the knobs are set on the local node
+	config.TransProtocol = "unix"
+	tuningFile := path.Join(dir, "test_tuning.log")
+	if err := ioutil.WriteFile(tuningFile, nil, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	prj := &project.YamlPrjSvr{Project: "test", Object: []*project.YamlPrjObj{{
+		Name: "a",
+		Info: project.YamlObj{GetScript: "echo 1", SetScript: "true", Type: "discrete", Dtype: "int",
+			Scope: []project.Quantity{1, 10}, Step: 1},
+	}}}
+	engine := optimizer.New(optimizer.BayesName)
+	_, err = engine.Post(&models.OptimizerPostBody{MaxEval: 10, RandomStarts: 10, Knobs: []models.Knob{
+		{Name: "a", Type: "discrete", Dtype: "int", Range: []float64{1, 10}, Step: 1, Ref: "1"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := &Optimizer{Prj: prj, EngineIns: engine, TuningFile: tuningFile, InitConfig: "a=1",
+		MaxIter: 10, PrjId: "1", EvalBase: "tps=-10", Evaluations: "evaluations=-10"}
+	o.startRun(optimizer.BayesName, 10, "")
+	if o.Run == nil {
+		t.Fatal("failed to record the tuning run")
+	}
+	return o
+}
+
+// replay send the evaluation of the last iteration to the optimizer the way
+// the client does, and return the state the client is asked for next
+func replay(t *testing.T, o *Optimizer, eval string, status string) PB.TuningMessageStatus {
+	if eval != "" {
+		o.Content = []byte("tps=" + eval)
+		o.Evaluations = "evaluations=" + eval
+	}
+	o.IterStatus = status
+
+	ch := make(chan *PB.TuningMessage, 100)
+	stopCh := make(chan int, 1)
+	if err := o.DynamicTuned(ch, stopCh); err != nil {
+		t.Fatalf("iteration %d failed: %v", o.Iter, err)
+	}
+	close(ch)
+	var state PB.TuningMessageStatus
+	for message := range ch {
+		if message.GetState() != PB.TuningMessage_Detail && message.GetState() != PB.TuningMessage_Display {
+			state = message.GetState()
+		}
+	}
+	return state
+}
+
+func TestDynamicTunedIterations(t *testing.T) {
+	o := newTestOptimizer(t)
+
+	o.Prj.Constraints = []string{"a > 100"}
+	if state := replay(t, o, "", ""); state != PB.TuningMessage_Threshold {
+		t.Fatalf("the state of the params violating the constraint is %v", state)
+	}
+	o.Prj.Constraints = nil
+	o.Prj.Object[0].Info.GetScript = "exit 1"
+	if state := replay(t, o, "-1", project.IterationInfeasible); state != PB.TuningMessage_ApplyFailed {
+		t.Fatalf("the state of the params failing to apply is %v", state)
+	}
+	o.Prj.Object[0].Info.GetScript = "echo 1"
+	if state := replay(t, o, "-1", project.IterationPenalized); state != PB.TuningMessage_BenchMark {
+		t.Fatalf("the state after the failed iteration is %v", state)
+	}
+	if state := replay(t, o, "-12", ""); state != PB.TuningMessage_BenchMark {
+		t.Fatalf("the state after the benchmarked iteration is %v", state)
+	}
+
+	want := []int{0, 1, 2, 3}
+	iterations, err := sqlstore.GetTuningIterations(o.Run.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iterations) != len(want) {
+		t.Fatalf("%d iterations are recorded, want %d", len(iterations), len(want))
+	}
+	for i, iteration := range iterations {
+		if iteration.Iteration != want[i] {
+			t.Errorf("the iteration recorded at %d is %d, want %d", i, iteration.Iteration, want[i])
+		}
+	}
+	for i, status := range []string{"", project.IterationInfeasible, project.IterationPenalized, ""} {
+		if iterations[i].Status != status {
+			t.Errorf("the status of iteration %d is %q, want %q", i, iterations[i].Status, status)
+		}
+	}
+
+	content, err := ioutil.ReadFile(o.TuningFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != len(want) {
+		t.Fatalf("%d iterations are written to the tuning log, want %d", len(lines), len(want))
+	}
+	for i, line := range lines {
+		if iter := strings.Split(line, "|")[0]; iter != strconv.Itoa(want[i]) {
+			t.Errorf("the iteration written at line %d is %s, want %d", i, iter, want[i])
+		}
+	}
+	if o.Iter != 4 {
+		t.Errorf("the iteration of the benchmark asked is %d, want 4", o.Iter)
+	}
+}
+
+func TestDynamicTunedSkip(t *testing.T) {
+	o := newTestOptimizer(t)
+	for _, status := range []string{"", project.IterationSkipped, ""} {
+		if state := replay(t, o, "-12", status); state != PB.TuningMessage_BenchMark {
+			t.Fatalf("the state after iteration %d is %v", o.Iter, state)
+		}
+	}
+
+	iterations, err := sqlstore.GetTuningIterations(o.Run.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(iterations) != 3 || iterations[1].Status != project.IterationSkipped {
+		t.Fatalf("the skipped iteration is not recorded: %+v", iterations)
+	}
+	content, err := ioutil.ReadFile(o.TuningFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(string(content)), "\n") {
+		if strings.Split(line, "|")[0] == "1" {
+			t.Errorf("the skipped iteration is written to the tuning log: %s", line)
+		}
+	}
+
+	body := &models.OptimizerPostBody{}
+	if err := o.readTuningHistory(body); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Xref) != 1 || len(body.Yref) != 1 {
+		t.Errorf("the history told to the optimizer is %v, want only iteration 2", body.Xref)
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		best   string
+		want   string
+	}{
+		{"keep", DisconnectKeep, "a=5", "3"},
+		{"best", StopBest, "a=5", "5"},
+		{"best without best params", StopBest, "", "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newTestOptimizer(t)
+			knob := path.Join(path.Dir(o.TuningFile), "a")
+			if err := ioutil.WriteFile(knob, []byte("3\n"), 0600); err != nil {
+				t.Fatal(err)
+			}
+			o.Prj.Object[0].Info.GetScript = "cat " + knob
+			o.Prj.Object[0].Info.SetScript = "echo $value > " + knob
+			o.Prj.DisconnectPolicy = tt.policy
+			o.BestParams = tt.best
+
+			o.Disconnect(make(chan *PB.TuningMessage, 1))
+			content, _ := ioutil.ReadFile(knob)
+			if got := strings.TrimSpace(string(content)); got != tt.want {
+				t.Errorf("the knob is %s after the disconnect, want %s", got, tt.want)
+			}
+		})
+	}
+}
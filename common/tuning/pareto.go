@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// ParetoPoint : a non-dominated iteration, the values are minimized
+type ParetoPoint struct {
+	Iter   int
+	Eval   string
+	Params string
+	values []float64
+}
+
+// parseEvalValues return the values of the objectives in the evaluation, the
+// constraints are not compared between the points
+func parseEvalValues(eval string, objectives []string) ([]float64, error) {
+	values := make([]float64, 0)
+	for _, item := range strings.Split(eval, ",") {
+		kvs := strings.Split(item, "=")
+		if len(kvs) != 2 {
+			return nil, fmt.Errorf("invalid evaluation %s", eval)
+		}
+		if !utils.CheckValueInSlice(strings.TrimSpace(kvs[0]), objectives) {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(kvs[1]), 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// dominates return true if a is not worse than b in all the values and
+// better in one of them
+func dominates(a []float64, b []float64) bool {
+	better := false
+	for i := range a {
+		if a[i] > b[i] {
+			return false
+		}
+		if a[i] < b[i] {
+			better = true
+		}
+	}
+	return better
+}
+
+// updatePareto method add the iteration to the Pareto front if it is not
+// dominated, and remove the iterations dominated by it
+func (o *Optimizer) updatePareto(iter int, eval string, params string) {
+	if o.ObjectiveMode != project.ObjectivePareto || iter == 0 {
+		return
+	}
+	values, err := parseEvalValues(eval, o.Objectives)
+	if err != nil {
+		return
+	}
+
+	front := make([]*ParetoPoint, 0, len(o.Pareto)+1)
+	for _, point := range o.Pareto {
+		if len(point.values) != len(values) {
+			continue
+		}
+		if dominates(point.values, values) || equalValues(point.values, values) {
+			return
+		}
+		if !dominates(values, point.values) {
+			front = append(front, point)
+		}
+	}
+	front = append(front, &ParetoPoint{Iter: iter, Eval: eval, Params: params, values: values})
+	sort.Slice(front, func(i, j int) bool { return front[i].Iter < front[j].Iter })
+	o.Pareto = front
+}
+
+func equalValues(a []float64, b []float64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ParetoMessage method return the Pareto-optimal configurations for the
+// payload of the Ending message of the tuning in pareto mode
+func (o *Optimizer) ParetoMessage() string {
+	if o.ObjectiveMode != project.ObjectivePareto || len(o.Pareto) == 0 {
+		return ""
+	}
+	message := " The Pareto-optimal configurations are:\n"
+	for _, point := range o.Pareto {
+		message = message + fmt.Sprintf("  iteration %d: (%s) %s\n", point.Iter,
+			DisplayEval(point.Eval), point.Params)
+	}
+	message = message + fmt.Sprintf(" Apply one of them by: atune-adm tuning --project %s --apply <iteration>\n",
+		o.Prj.Project)
+	return message
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// rebootDelay : the delay before reboot, so that the client receives the message
+const rebootDelay = 3 * time.Second
+
+// RebootState : the state of the tuning job saved before reboot, the params
+// of the iteration are benchmarked when the job is continued after reboot
+type RebootState struct {
+	JobID   string   `json:"job_id"`
+	Project string   `json:"project"`
+	Iter    int      `json:"iteration"`
+	Params  string   `json:"params"`
+	Knobs   []string `json:"knobs"`
+}
+
+// reappliedJobs : the jobs whose params are applied again since atuned
+// started, it is not saved in the state, so the params are applied again on
+// every boot until the job is continued and the state is removed
+var reappliedJobs = struct {
+	sync.Mutex
+	ids map[string]bool
+}{ids: make(map[string]bool)}
+
+func rebootStateFile(project string) string {
+	return path.Join(config.DefaultTuningLogPath, project+config.TuningRebootState)
+}
+
+func (s *RebootState) save() error {
+	content, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFile(rebootStateFile(s.Project), string(content), utils.FilePerm,
+		os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+}
+
+// RebootStates method return the saved states of the jobs waiting to
+// continue after reboot
+func RebootStates() ([]*RebootState, error) {
+	files, err := filepath.Glob(path.Join(config.DefaultTuningLogPath, "*"+config.TuningRebootState))
+	if err != nil {
+		return nil, err
+	}
+	states := make([]*RebootState, 0, len(files))
+	for _, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		state := new(RebootState)
+		if err := json.Unmarshal(content, state); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", file, err)
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// GetRebootState method return the saved state of the job, nil if the job
+// is not waiting to continue after reboot
+func GetRebootState(jobID string) (*RebootState, error) {
+	states, err := RebootStates()
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range states {
+		if state.JobID == strings.TrimSpace(jobID) {
+			return state, nil
+		}
+	}
+	return nil, nil
+}
+
+// reboot method save the state of the job and reboot the system, the changed
+// knobs need reboot to take effect before the benchmark of the iteration
+func (o *Optimizer) reboot(ch chan *PB.TuningMessage, stopCh chan int, knobs []string) error {
+	state := &RebootState{
+		JobID:   o.PrjId,
+		Project: o.Prj.Project,
+		Iter:    o.Iter + 1,
+		Params:  o.RespPutIns.Param,
+		Knobs:   knobs,
+	}
+	if err := state.save(); err != nil {
+		log.Errorf("failed to save the reboot state of %s: %v", o.Prj.Project, err)
+		return err
+	}
+
+	message := fmt.Sprintf("\n The knobs %s of iteration %d need reboot, the system is rebooting.\n"+
+		" Continue the tuning after reboot by: atune-adm tuning --attach %s PROJECT_YAML\n",
+		strings.Join(knobs, ","), state.Iter, o.PrjId)
+	if err := o.endTuning(ch, stopCh, "", sqlstore.TuningRebooting, message); err != nil {
+		return err
+	}
+
+	go func() {
+		time.Sleep(rebootDelay)
+		log.Infof("reboot the system for job %s of %s: %s", o.PrjId, o.Prj.Project, config.RebootCommand)
+		if _, err := project.ExecCommand(config.RebootCommand); err != nil {
+			log.Errorf("failed to reboot the system: %v", err)
+		}
+	}()
+	return nil
+}
+
+// ReapplyReboot method apply the params of the job again after reboot, the
+// knobs which are not persistent are lost during reboot
+func (o *Optimizer) ReapplyReboot(state *RebootState) error {
+	reappliedJobs.Lock()
+	defer reappliedJobs.Unlock()
+	if reappliedJobs.ids[state.JobID] {
+		return nil
+	}
+	log.Infof("reapply the params of job %s of %s after reboot: %s", state.JobID, state.Project, state.Params)
+	if err := o.applyParams(state.Params); err != nil {
+		return err
+	}
+	reappliedJobs.ids[state.JobID] = true
+	return nil
+}
+
+// Resume method continue the job after reboot by the attached client
+func (o *Optimizer) Resume(state *RebootState) error {
+	restoreConf, err := ioutil.ReadFile(path.Join(config.DefaultTuningLogPath,
+		o.Prj.Project+config.TuningRestoreConfig))
+	if err != nil {
+		return err
+	}
+	if err := o.ReapplyReboot(state); err != nil {
+		return err
+	}
+	o.InitConfig = string(restoreConf)
+	o.BackupFlag = true
+	o.Restart = true
+	o.PrjId = state.JobID
+	o.resume = state
+	return nil
+}
+
+// benchmarkResumed method send the params of the iteration before reboot to
+// the client, the optimizer task is created after its benchmark
+func (o *Optimizer) benchmarkResumed(ch chan *PB.TuningMessage) error {
+	body := new(models.OptimizerPostBody)
+	if err := o.readTuningHistory(body); err != nil {
+		return err
+	}
+	if o.Run == nil || o.Run.JobID != o.PrjId {
+		return fmt.Errorf("tuning run of job %s is not found", o.PrjId)
+	}
+	o.Run.Status = sqlstore.TuningRunning
+	if err := sqlstore.UpdateTuningRun(o.Run); err != nil {
+		return err
+	}
+
+	o.TuningFile = path.Join(config.DefaultTuningLogPath, fmt.Sprintf("%s_%s", o.Prj.Project, config.TuningFile))
+	o.Iter = o.resume.Iter
+	o.RespPutIns = &models.RespPutBody{Param: o.resume.Params}
+	o.Prj.SetApplied(o.resume.Params)
+	o.StartIterTime = time.Now().Format(config.DefaultTimeFormat)
+	ch <- &PB.TuningMessage{
+		State:   PB.TuningMessage_JobInit,
+		Content: []byte(strconv.Itoa(int(o.Run.MaxIterations))),
+		TuningLog: &PB.TuningHistory{
+			BaseEval:  o.EvalBase,
+			MinEval:   o.EvalMinArray,
+			SumEval:   fmt.Sprintf("%.2f", o.MinEvalSum),
+			TotalTime: int64(o.TotalTime),
+			Starts:    int32(o.Iter),
+		},
+	}
+
+	if err := os.Remove(rebootStateFile(o.Prj.Project)); err != nil {
+		log.Warnf("failed to remove the reboot state of %s: %v", o.Prj.Project, err)
+	}
+	reappliedJobs.Lock()
+	delete(reappliedJobs.ids, o.PrjId)
+	reappliedJobs.Unlock()
+	log.Infof("continue job %s of %s after reboot, benchmark the params of iteration %d",
+		o.PrjId, o.Prj.Project, o.Iter)
+	o.resume = nil
+	o.TotalTime = 0
+	ch <- &PB.TuningMessage{State: PB.TuningMessage_BenchMark, Content: []byte(o.RespPutIns.Param)}
+	return nil
+}
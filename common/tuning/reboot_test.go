@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"gitee.com/openeuler/A-Tune/common/project"
+)
+
+func TestReapplyReboot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "reboot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	applied := path.Join(dir, "applied")
+
+	o := &Optimizer{Prj: &project.YamlPrjSvr{Project: "test", Object: []*project.YamlPrjObj{{
+		Name: "a",
+		Info: project.YamlObj{GetScript: "echo 1", SetScript: "echo $value >> " + applied,
+			Type: "discrete", Dtype: "int"},
+	}}}}
+	state := &RebootState{JobID: "1", Project: "test", Iter: 3, Params: "a=4"}
+	count := func() int {
+		content, err := ioutil.ReadFile(applied)
+		if err != nil {
+			return 0
+		}
+		return len(strings.Fields(string(content)))
+	}
+
+	for boot := 1; boot <= 2; boot++ {
+		// atuned applies the params when it is ready, and again when the
+		// client attaches in the same boot
+		for i := 0; i < 2; i++ {
+			if err := o.ReapplyReboot(state); err != nil {
+				t.Fatalf("ReapplyReboot failed: %v", err)
+			}
+		}
+		if count() != boot {
+			t.Fatalf("the params are applied %d times after boot %d, want %d", count(), boot, boot)
+		}
+		// the next boot starts atuned without the jobs applied
+		reappliedJobs.ids = make(map[string]bool)
+	}
+}
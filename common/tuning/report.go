@@ -0,0 +1,350 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	htmlTemplate "html/template"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// the formats of the tuning report
+const (
+	ReportMarkdown = "markdown"
+	ReportHTML     = "html"
+	ReportJSON     = "json"
+)
+
+// ReportFormats : the supported formats of the tuning report
+var ReportFormats = []string{ReportMarkdown, ReportHTML, ReportJSON}
+
+// Report : the tuning report generated from the stored history
+type Report struct {
+	Project     string             `json:"project"`
+	JobID       string             `json:"job_id"`
+	Engine      string             `json:"engine"`
+	Status      string             `json:"status"`
+	StartTime   string             `json:"start_time"`
+	EndTime     string             `json:"end_time"`
+	TimeSpent   string             `json:"time_spent"`
+	Iterations  int                `json:"iterations"`
+	BestIter    int                `json:"best_iteration"`
+	BestParams  string             `json:"best_params"`
+	Evaluations []ReportEvaluation `json:"evaluations"`
+	Convergence []ReportIteration  `json:"convergence"`
+	Importance  []ReportImportance `json:"importance"`
+	Failed      []ReportIteration  `json:"failed_iterations"`
+}
+
+// ReportEvaluation : the baseline and the best value of an evaluation
+type ReportEvaluation struct {
+	Name        string  `json:"name"`
+	Baseline    float64 `json:"baseline"`
+	Best        float64 `json:"best"`
+	Improvement float64 `json:"improvement"`
+}
+
+// ReportIteration : one point of the convergence series
+type ReportIteration struct {
+	Iteration   int     `json:"iteration"`
+	Evaluations string  `json:"evaluations"`
+	EvalSum     float64 `json:"eval_sum"`
+	BestSum     float64 `json:"best_eval_sum"`
+	Status      string  `json:"status,omitempty"`
+	Params      string  `json:"params"`
+}
+
+// ReportImportance : the importance of a parameter given by the optimizer
+type ReportImportance struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// NewReport generate the report of the last tuning run of the job, or
+// of the project if no job has the id
+func NewReport(name string) (*Report, error) {
+	run, err := sqlstore.GetJobTuningRun(name)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		if run, err = sqlstore.GetLastTuningRun(name); err != nil {
+			return nil, err
+		}
+	}
+	if run == nil {
+		return nil, fmt.Errorf("no tuning history of job or project %s", name)
+	}
+
+	iterations, err := sqlstore.GetTuningIterations(run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Project:     run.Project,
+		JobID:       run.JobID,
+		Engine:      run.Engine,
+		Status:      run.Status,
+		StartTime:   run.StartTime.Format(config.DefaultTimeFormat),
+		Evaluations: make([]ReportEvaluation, 0),
+		Convergence: make([]ReportIteration, 0),
+		Failed:      make([]ReportIteration, 0),
+	}
+	endTime := run.EndTime
+	if endTime.IsZero() && len(iterations) > 0 {
+		endTime = iterations[len(iterations)-1].EndTime
+	}
+	if !endTime.IsZero() {
+		report.EndTime = endTime.Format(config.DefaultTimeFormat)
+		report.TimeSpent = endTime.Sub(run.StartTime).Round(time.Second).String()
+	}
+
+	var baseline, best *sqlstore.TuningIteration
+	for _, iteration := range iterations {
+		if iteration.Iteration == 0 {
+			baseline = iteration
+			continue
+		}
+		report.Iterations++
+		if iteration.Status != "" {
+			report.Failed = append(report.Failed, reportIteration(iteration))
+		} else if best == nil || iteration.EvalSum < best.EvalSum {
+			best = iteration
+		}
+		point := reportIteration(iteration)
+		if best != nil {
+			point.BestSum = best.EvalSum
+		}
+		report.Convergence = append(report.Convergence, point)
+	}
+
+	if best != nil {
+		report.BestIter = best.Iteration
+		report.BestParams = best.Params
+	}
+	if baseline != nil && best != nil {
+		report.Evaluations = reportEvaluations(baseline.Evaluations, best.Evaluations)
+	}
+	report.Importance = reportImportance(run.Importance)
+	return report, nil
+}
+
+func reportIteration(iteration *sqlstore.TuningIteration) ReportIteration {
+	return ReportIteration{
+		Iteration:   iteration.Iteration,
+		Evaluations: strings.Replace(iteration.Evaluations, "=-", "=", -1),
+		EvalSum:     iteration.EvalSum,
+		Status:      iteration.Status,
+		Params:      iteration.Params,
+	}
+}
+
+// reportEvaluations compare the best evaluations with the baseline, the
+// stored values are minimized, so the improvement is the decrease of them
+func reportEvaluations(baseline string, best string) []ReportEvaluation {
+	bestValues := make(map[string]float64)
+	for _, item := range strings.Split(best, ",") {
+		kvs := strings.Split(item, "=")
+		if len(kvs) != 2 {
+			continue
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(kvs[1]), 64)
+		if err != nil {
+			continue
+		}
+		bestValues[kvs[0]] = value
+	}
+
+	evaluations := make([]ReportEvaluation, 0)
+	for _, item := range strings.Split(baseline, ",") {
+		kvs := strings.Split(item, "=")
+		if len(kvs) != 2 {
+			continue
+		}
+		base, err := strconv.ParseFloat(strings.TrimSpace(kvs[1]), 64)
+		if err != nil {
+			continue
+		}
+		value, ok := bestValues[kvs[0]]
+		if !ok {
+			continue
+		}
+		evaluation := ReportEvaluation{Name: kvs[0], Baseline: math.Abs(base), Best: math.Abs(value)}
+		if !utils.IsEquals(base, 0.0) {
+			evaluation.Improvement = (base - value) / math.Abs(base) * 100
+		}
+		evaluations = append(evaluations, evaluation)
+	}
+	return evaluations
+}
+
+func reportImportance(rank string) []ReportImportance {
+	sortedParams := make(utils.SortedPair, 0)
+	for _, param := range strings.Split(rank, ",") {
+		paramPair := strings.Split(param, ":")
+		if len(paramPair) != 2 {
+			continue
+		}
+		score, err := strconv.ParseFloat(strings.TrimSpace(paramPair[1]), 64)
+		if err != nil {
+			continue
+		}
+		sortedParams = append(sortedParams, utils.Pair{Name: strings.TrimSpace(paramPair[0]), Score: score})
+	}
+	sort.Sort(sortedParams)
+
+	importance := make([]ReportImportance, 0, len(sortedParams))
+	for _, param := range sortedParams {
+		importance = append(importance, ReportImportance{Name: param.Name, Score: param.Score})
+	}
+	return importance
+}
+
+const markdownReport = `# Tuning report of {{.Project}}
+
+| Item | Value |
+| ---- | ----- |
+| Job | {{.JobID}} |
+| Engine | {{.Engine}} |
+| Status | {{.Status}} |
+| Start time | {{.StartTime}} |
+| End time | {{.EndTime}} |
+| Time spent | {{.TimeSpent}} |
+| Iterations | {{.Iterations}} |
+| Failed iterations | {{len .Failed}} |
+
+## Baseline versus best
+
+| Evaluation | Baseline | Best | Improvement |
+| ---------- | -------- | ---- | ----------- |
+{{range .Evaluations}}| {{.Name}} | {{printf "%.2f" .Baseline}} | {{printf "%.2f" .Best}} | {{printf "%.2f" .Improvement}}% |
+{{end}}
+## Best parameters
+
+{{if .BestParams}}Iteration {{.BestIter}}: ` + "`{{.BestParams}}`" + `{{else}}No successful iteration.{{end}}
+
+## Parameter importance
+
+{{if .Importance}}| Rank | Parameter | Score |
+| ---- | --------- | ----- |
+{{range $i, $p := .Importance}}| {{inc $i}} | {{$p.Name}} | {{printf "%.4f" $p.Score}} |
+{{end}}{{else}}Not reported by the optimizer.
+{{end}}
+## Convergence
+
+| Iteration | Evaluations | Sum | Best sum | Status |
+| --------- | ----------- | --- | -------- | ------ |
+{{range .Convergence}}| {{.Iteration}} | {{.Evaluations}} | {{printf "%.2f" .EvalSum}} | {{printf "%.2f" .BestSum}} | {{.Status}} |
+{{end}}
+## Failed iterations
+
+{{if .Failed}}| Iteration | Status | Parameters |
+| --------- | ------ | ---------- |
+{{range .Failed}}| {{.Iteration}} | {{.Status}} | ` + "`{{.Params}}`" + ` |
+{{end}}{{else}}None.
+{{end}}`
+
+const htmlReport = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Tuning report of {{.Project}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+th { background: #f0f0f0; }
+</style>
+</head>
+<body>
+<h1>Tuning report of {{.Project}}</h1>
+<table>
+<tr><th>Job</th><td>{{.JobID}}</td></tr>
+<tr><th>Engine</th><td>{{.Engine}}</td></tr>
+<tr><th>Status</th><td>{{.Status}}</td></tr>
+<tr><th>Start time</th><td>{{.StartTime}}</td></tr>
+<tr><th>End time</th><td>{{.EndTime}}</td></tr>
+<tr><th>Time spent</th><td>{{.TimeSpent}}</td></tr>
+<tr><th>Iterations</th><td>{{.Iterations}}</td></tr>
+<tr><th>Failed iterations</th><td>{{len .Failed}}</td></tr>
+</table>
+<h2>Baseline versus best</h2>
+<table>
+<tr><th>Evaluation</th><th>Baseline</th><th>Best</th><th>Improvement</th></tr>
+{{range .Evaluations}}<tr><td>{{.Name}}</td><td>{{printf "%.2f" .Baseline}}</td><td>{{printf "%.2f" .Best}}</td><td>{{printf "%.2f" .Improvement}}%</td></tr>
+{{end}}</table>
+<h2>Best parameters</h2>
+{{if .BestParams}}<p>Iteration {{.BestIter}}: <code>{{.BestParams}}</code></p>{{else}}<p>No successful iteration.</p>{{end}}
+<h2>Parameter importance</h2>
+{{if .Importance}}<table>
+<tr><th>Rank</th><th>Parameter</th><th>Score</th></tr>
+{{range $i, $p := .Importance}}<tr><td>{{inc $i}}</td><td>{{$p.Name}}</td><td>{{printf "%.4f" $p.Score}}</td></tr>
+{{end}}</table>{{else}}<p>Not reported by the optimizer.</p>{{end}}
+<h2>Convergence</h2>
+<table>
+<tr><th>Iteration</th><th>Evaluations</th><th>Sum</th><th>Best sum</th><th>Status</th></tr>
+{{range .Convergence}}<tr><td>{{.Iteration}}</td><td>{{.Evaluations}}</td><td>{{printf "%.2f" .EvalSum}}</td><td>{{printf "%.2f" .BestSum}}</td><td>{{.Status}}</td></tr>
+{{end}}</table>
+<h2>Failed iterations</h2>
+{{if .Failed}}<table>
+<tr><th>Iteration</th><th>Status</th><th>Parameters</th></tr>
+{{range .Failed}}<tr><td>{{.Iteration}}</td><td>{{.Status}}</td><td><code>{{.Params}}</code></td></tr>
+{{end}}</table>{{else}}<p>None.</p>{{end}}
+</body>
+</html>
+`
+
+func inc(i int) int {
+	return i + 1
+}
+
+// Format method render the report as markdown, html or json
+func (r *Report) Format(format string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case ReportMarkdown, "":
+		tmpl, err := template.New("report").Funcs(template.FuncMap{"inc": inc}).Parse(markdownReport)
+		if err != nil {
+			return nil, err
+		}
+		if err := tmpl.Execute(&buf, r); err != nil {
+			return nil, err
+		}
+	case ReportHTML:
+		tmpl, err := htmlTemplate.New("report").Funcs(htmlTemplate.FuncMap{"inc": inc}).Parse(htmlReport)
+		if err != nil {
+			return nil, err
+		}
+		if err := tmpl.Execute(&buf, r); err != nil {
+			return nil, err
+		}
+	case ReportJSON:
+		return json.MarshalIndent(r, "", "  ")
+	default:
+		return nil, fmt.Errorf("the format of the report must be in %v", ReportFormats)
+	}
+	return buf.Bytes(), nil
+}
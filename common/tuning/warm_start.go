@@ -0,0 +1,204 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package tuning
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/models"
+	"gitee.com/openeuler/A-Tune/common/sqlstore"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// warmSeed : the params and the evaluation of a prior iteration, the weight
+// in [0, 1] is the shrinkage of the evaluation of the seed from another run
+// or host toward the mean of the seeds, it is not a sample weight
+type warmSeed struct {
+	params  string
+	evalSum float64
+	weight  float64
+}
+
+// warmStartSeeds method read the seeds from the history file sent by the
+// client, or from the last tuning run of the job in the database
+func (o *Optimizer) warmStartSeeds() ([]warmSeed, error) {
+	if len(o.WarmStartData) > 0 {
+		return parseWarmStartFile(o.WarmStartData, o.WarmStartWeight)
+	}
+
+	run, err := sqlstore.GetJobTuningRun(o.WarmStart)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, fmt.Errorf("no tuning history of job %s", o.WarmStart)
+	}
+	iterations, err := sqlstore.GetTuningIterations(run.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds := make([]warmSeed, 0, len(iterations))
+	for _, iteration := range iterations {
+		if iteration.Iteration == 0 || iteration.Status != "" {
+			continue
+		}
+		seeds = append(seeds, warmSeed{params: iteration.Params, evalSum: iteration.EvalSum,
+			weight: o.WarmStartWeight})
+	}
+	return seeds, nil
+}
+
+// parseWarmStartFile parse the json report of the tuning, or the tuning log
+// whose lines are iteration|start|end|evaluations|evaluation|params with an
+// optional weight column
+func parseWarmStartFile(data []byte, weight float64) ([]warmSeed, error) {
+	seeds := make([]warmSeed, 0)
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		report := struct {
+			Convergence []struct {
+				Iteration int      `json:"iteration"`
+				EvalSum   float64  `json:"eval_sum"`
+				Status    string   `json:"status"`
+				Params    string   `json:"params"`
+				Weight    *float64 `json:"weight"`
+			} `json:"convergence"`
+		}{}
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("failed to parse the warm start report: %v", err)
+		}
+		for _, point := range report.Convergence {
+			if point.Iteration == 0 || point.Status != "" || point.Params == "" {
+				continue
+			}
+			seed := warmSeed{params: point.Params, evalSum: point.EvalSum, weight: weight}
+			if point.Weight != nil {
+				seed.weight = *point.Weight
+			}
+			seeds = append(seeds, seed)
+		}
+		return seeds, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		items := strings.Split(scanner.Text(), "|")
+		if len(items) != 6 && len(items) != 7 {
+			continue
+		}
+		if iter, err := strconv.Atoi(items[0]); err != nil || iter == 0 {
+			continue
+		}
+		evalSum, err := utils.CalculateBenchMark(items[3])
+		if err != nil {
+			return nil, fmt.Errorf("invalid warm start line %s: %v", scanner.Text(), err)
+		}
+		seed := warmSeed{params: items[5], evalSum: evalSum, weight: weight}
+		if len(items) == 7 {
+			if seed.weight, err = strconv.ParseFloat(strings.TrimSpace(items[6]), 64); err != nil {
+				return nil, fmt.Errorf("invalid weight of warm start line %s", scanner.Text())
+			}
+		}
+		seeds = append(seeds, seed)
+	}
+	return seeds, nil
+}
+
+// addWarmStart method check the knobs of the seeds and append them to the
+// xref and yref of the optimizer, it returns the count of the seeds. The
+// engines take no sample weight, so the weight is applied as shrinkage: the
+// evaluation sent for the seed is mean + weight * (evaluation - mean), the
+// seed with weight 0 only tells the optimizer the point is average, and the
+// seed with weight 1 is sent as it is measured
+func (o *Optimizer) addWarmStart(body *models.OptimizerPostBody) (int, error) {
+	if o.WarmStart == "" || o.FeatureFilter {
+		return 0, nil
+	}
+	seeds, err := o.warmStartSeeds()
+	if err != nil {
+		return 0, err
+	}
+
+	xrefs := make([][]string, 0, len(seeds))
+	yrefs := make([]float64, 0, len(seeds))
+	weights := make([]float64, 0, len(seeds))
+	for _, seed := range seeds {
+		if seed.weight < 0 || seed.weight > 1 {
+			return 0, fmt.Errorf("the weight of warm start seed %s must be in [0, 1]", seed.params)
+		}
+		xref, err := o.warmStartXref(seed.params)
+		if err != nil {
+			return 0, err
+		}
+		if xref == nil {
+			continue
+		}
+		xrefs = append(xrefs, xref)
+		yrefs = append(yrefs, seed.evalSum)
+		weights = append(weights, seed.weight)
+	}
+
+	mean := utils.Mean(yrefs)
+	for i := range xrefs {
+		body.Xref = append(body.Xref, xrefs[i])
+		yref := mean + weights[i]*(yrefs[i]-mean)
+		body.Yref = append(body.Yref, strconv.FormatFloat(yref, 'f', -1, 64))
+	}
+	log.Infof("warm start the tuning of %s with %d of %d seeds from %s",
+		o.Prj.Project, len(xrefs), len(seeds), o.WarmStart)
+	return len(xrefs), nil
+}
+
+// warmStartXref method return the xref of the seed in the order of the
+// knobs, the knob names must match the project, and the seed out of the
+// range of any knob is skipped
+func (o *Optimizer) warmStartXref(params string) ([]string, error) {
+	values := make(map[string]string)
+	for _, para := range strings.Split(params, ",") {
+		kvs := strings.SplitN(para, "=", 2)
+		if len(kvs) != 2 {
+			continue
+		}
+		name := strings.TrimSpace(kvs[0])
+		if !o.active(name) {
+			return nil, fmt.Errorf("knob %s of the warm start seed is not in project %s",
+				name, o.Prj.Project)
+		}
+		values[name] = strings.TrimSpace(kvs[1])
+	}
+
+	xref := make([]string, 0, len(values))
+	for _, obj := range o.Prj.Object {
+		if obj.Info.Skip {
+			continue
+		}
+		value, ok := values[obj.Name]
+		if !ok {
+			return nil, fmt.Errorf("knob %s of project %s is not in the warm start seed",
+				obj.Name, o.Prj.Project)
+		}
+		if !obj.Info.InRange(value) {
+			log.Warnf("skip the warm start seed whose %s=%s is out of range", obj.Name, value)
+			return nil, nil
+		}
+		xref = append(xref, o.Prj.EncodeParams(obj.Name+"="+value))
+	}
+	return xref, nil
+}
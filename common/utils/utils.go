@@ -31,6 +31,7 @@ import (
 	"plugin"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"syscall"
@@ -575,6 +576,64 @@ func StandardDeviation(data []float64) float64 {
 	return math.Sqrt(Variance(data))
 }
 
+// Median calculate the median value
+func Median(data []float64) float64 {
+	if len(data) == 0 {
+		return 0
+	}
+	sorted := append([]float64{}, data...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// TrimmedMean calculate the average value without the min and max value
+func TrimmedMean(data []float64) float64 {
+	if len(data) < 3 {
+		return Mean(data)
+	}
+	sorted := append([]float64{}, data...)
+	sort.Float64s(sorted)
+	return Mean(sorted[1 : len(sorted)-1])
+}
+
+// Aggregate calculate the value of the data by the method,
+// which is median, mean, trimmed_mean, min or max
+func Aggregate(data []float64, method string) (float64, error) {
+	if len(data) == 0 {
+		return 0, fmt.Errorf("no data to aggregate")
+	}
+	switch method {
+	case "", "mean":
+		return Mean(data), nil
+	case "median":
+		return Median(data), nil
+	case "trimmed_mean":
+		return TrimmedMean(data), nil
+	case "min":
+		sorted := append([]float64{}, data...)
+		sort.Float64s(sorted)
+		return sorted[0], nil
+	case "max":
+		sorted := append([]float64{}, data...)
+		sort.Float64s(sorted)
+		return sorted[len(sorted)-1], nil
+	}
+	return 0, fmt.Errorf("unknown aggregate method %s", method)
+}
+
+// CoefficientOfVariation calculate the standard deviation divided by the mean
+func CoefficientOfVariation(data []float64) float64 {
+	mean := Mean(data)
+	if IsEquals(mean, 0) {
+		return 0
+	}
+	return StandardDeviation(data) / math.Abs(mean)
+}
+
 // ChangeFileName: Change file name
 func ChangeFileName(dataPath string) (string, string, error) {
 	dir, fileName := filepath.Split(dataPath)
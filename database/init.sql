@@ -68,6 +68,36 @@ CREATE TABLE IF NOT EXISTS profile_log(
 );
 
 
+CREATE TABLE IF NOT EXISTS tuning_run(
+  id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+  job_id TEXT NOT NULL,
+  project TEXT NOT NULL,
+  engine TEXT NOT NULL,
+  task_id TEXT,
+  feature_filter BOOLEN NOT NULL,
+  max_iterations INTEGER NOT NULL,
+  status TEXT NOT NULL,
+  importance TEXT,
+  start_time DATETIME NOT NULL,
+  end_time DATETIME
+);
+
+
+CREATE TABLE IF NOT EXISTS tuning_iteration(
+  id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+  run_id INTEGER NOT NULL,
+  iteration INTEGER NOT NULL,
+  params TEXT NOT NULL,
+  applied TEXT,
+  evaluations TEXT NOT NULL,
+  eval_sum REAL NOT NULL,
+  status TEXT,
+  start_time DATETIME,
+  end_time DATETIME,
+  FOREIGN KEY(run_id) REFERENCES tuning_run(id)
+);
+
+
 drop table if exists schedule;
 CREATE TABLE IF NOT EXISTS schedule(
   id INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
@@ -16,6 +16,8 @@ package profile
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -26,6 +28,7 @@ import (
 	PB "gitee.com/openeuler/A-Tune/api/profile"
 	"gitee.com/openeuler/A-Tune/common/client"
 	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/optimizer"
 	"gitee.com/openeuler/A-Tune/common/project"
 	SVC "gitee.com/openeuler/A-Tune/common/service"
 	"gitee.com/openeuler/A-Tune/common/utils"
@@ -55,12 +58,69 @@ var profileTunningCommand = cli.Command{
 			Hidden: false,
 			Usage:  "display the detail info of tuning message",
 		},
+		cli.StringFlag{
+			Name:  "attach,a",
+			Usage: "attach to the running job and display its tuning message, or continue the job after reboot or interruption with PROJECT_YAML",
+			Value: "",
+		},
+		cli.StringFlag{
+			Name:  "warm-start",
+			Usage: "seed the tuning with the history file or the tuning job of other runs or hosts",
+			Value: "",
+		},
+		cli.Float64Flag{
+			Name:  "warm-start-weight",
+			Usage: "the shrinkage weight in [0, 1] of the warm start seeds without their own weight",
+			Value: 1,
+		},
+		cli.StringFlag{
+			Name:  "export-profile",
+			Usage: "export the best result of the last tuning as the profile service-app-scenario",
+			Value: "",
+		},
+		cli.StringFlag{
+			Name:  "apply",
+			Usage: "apply the params of the iteration of the last tuning, such as a Pareto-optimal one",
+			Value: "",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "check the project and run the benchmark once without changing anything",
+		},
+	},
+	Subcommands: []cli.Command{
+		profileTuningListCommand,
+		profileTuningPauseCommand,
+		profileTuningResumeCommand,
+		profileTuningStopCommand,
+		profileTuningStatusCommand,
+		profileTuningReportCommand,
 	},
 	Description: func() string {
 		desc := `
 	 tuning command usning bayes method dynamic search optimal parameter sets,
 	 the PROJECT_YAML which you can refer to Documentation example.yaml.
 	     example: atune-adm tuning ./example.yaml
+	 seed the tuning with the history file or the tuning job of other runs or hosts.
+	     example: atune-adm tuning --warm-start ./example_tuning.log --warm-start-weight 0.5 ./example.yaml
+	 check the project, the scripts and the benchmark without changing anything.
+	     example: atune-adm tuning --dry-run ./example.yaml
+	 apply one of the Pareto-optimal iterations of the last tuning.
+	     example: atune-adm tuning --project example --apply 12
+	 export the best result of the last tuning as a profile.
+	     example: atune-adm tuning --project example --export-profile web-nginx-tuned
+	 list the running jobs or attach to one of them.
+	     example: atune-adm tuning list
+	              atune-adm tuning --attach <job>
+	 continue the job after the reboot for the knobs which need reboot,
+	 or the job interrupted by the restart of atuned.
+	     example: atune-adm tuning --attach <job> ./example.yaml
+	 pause, resume or stop the running job, or show its status.
+	     example: atune-adm tuning pause <job>
+	              atune-adm tuning stop --apply best <job>
+	              atune-adm tuning status <job>
+	 generate the report of the last tuning of the project or the job.
+	     example: atune-adm tuning report --format html --output report.html <project|job>
 	`
 		return desc
 	}(),
@@ -88,6 +148,18 @@ func profileTunning(ctx *cli.Context) error {
 		return checkRestoreConfig(ctx)
 	}
 
+	if ctx.String("attach") != "" && ctx.NArg() == 0 {
+		return attachTuningJob(ctx)
+	}
+
+	if ctx.String("apply") != "" {
+		return applyTuningIteration(ctx)
+	}
+
+	if ctx.String("export-profile") != "" {
+		return exportTuningProfile(ctx)
+	}
+
 	if err := utils.CheckArgs(ctx, 1, utils.ConstExactArgs); err != nil {
 		return err
 	}
@@ -104,20 +176,41 @@ func profileTunning(ctx *cli.Context) error {
 	prj.EvalBaseArray = make([]float64, len(prj.Evaluations))
 	prj.EvalCurrentArray = make([]float64, len(prj.Evaluations))
 	prj.EvalMinArray = make([]float64, len(prj.Evaluations))
+	prj.EvalWorstArray = make([]float64, len(prj.Evaluations))
 	if err := checkTuningPrjYaml(&prj); err != nil {
 		return err
 	}
-	err := runTuningRPC(ctx, func(stream PB.ProfileMgr_TuningClient) error {
+	if prj.BenchmarkTarget != "" {
+		executor, err := newAgentExecutor(prj.BenchmarkTarget)
+		if err != nil {
+			return err
+		}
+		defer executor.Close()
+		prj.Executor = executor
+	}
+	if ctx.Bool("dry-run") {
+		return dryRunTuning(ctx, &prj)
+	}
+	restart := ctx.Bool("restart") || ctx.String("attach") != ""
+	maxDuration, _ := time.ParseDuration(prj.MaxDuration)
+	warmStartData, err := readWarmStart(ctx)
+	if err != nil {
+		return err
+	}
+	err = runTuningRPC(ctx, func(stream PB.ProfileMgr_TuningClient) error {
 		finished := make(chan bool)
 		errors := make(chan error)
 		var init bool = false
 		go func() {
-			if !ctx.Bool("restart") {
+			if !restart {
 				fmt.Println(" Start to benchmark baseline...")
 				_, _, err := prj.BenchMark()
 				if err != nil {
 					errors <- err
 				}
+				if prj.Violated() {
+					fmt.Printf(" The baseline violates the constraints: %s\n", strings.Join(prj.Violations, ","))
+				}
 				finished <- true
 				return
 			}
@@ -126,7 +219,8 @@ func profileTunning(ctx *cli.Context) error {
 		var state PB.TuningMessageStatus = PB.TuningMessage_JobInit
 		content := &PB.TuningMessage{
 			Name:                ctx.String("project"),
-			Restart:             ctx.Bool("restart"),
+			Id:                  ctx.String("attach"),
+			Restart:             restart,
 			RandomStarts:        prj.RandomStarts,
 			Engine:              prj.Engine,
 			State:               state,
@@ -138,6 +232,15 @@ func profileTunning(ctx *cli.Context) error {
 			SplitCount:          prj.SplitCount,
 			EvalFluctuation:     prj.EvalFluctuation,
 			FeatureSelector:     prj.FeatureSelector,
+			MaxDuration:         int64(maxDuration.Seconds()),
+			TargetImprovement:   prj.TargetImprovement,
+			PlateauIters:        prj.PlateauIters,
+			PlateauImprovement:  prj.PlateauImprovement,
+			ObjectiveMode:       prj.ObjectiveMode,
+			Objectives:          prj.ObjectiveNames(),
+			WarmStart:           ctx.String("warm-start"),
+			WarmStartData:       warmStartData,
+			WarmStartWeight:     ctx.Float64("warm-start-weight"),
 		}
 		if err := stream.Send(content); err != nil {
 			return fmt.Errorf("client sends failure, error: %v", err)
@@ -162,6 +265,7 @@ func profileTunning(ctx *cli.Context) error {
 						break
 					}
 					init = true
+					fmt.Printf(" Tuning job ID: %s\n", reply.GetId())
 				}
 				content := &PB.TuningMessage{
 					State: PB.TuningMessage_JobCreate,
@@ -180,7 +284,7 @@ func profileTunning(ctx *cli.Context) error {
 					return err
 				}
 				prj.TotalIters = int32(iterations)
-				if ctx.Bool("restart") {
+				if restart {
 					prj.SetHistoryEvalBase(reply.GetTuningLog())
 				}
 			case PB.TuningMessage_JobRestart:
@@ -190,9 +294,26 @@ func profileTunning(ctx *cli.Context) error {
 				}
 			case PB.TuningMessage_BenchMark:
 				prj.Params = string(reply.GetContent())
+				status := ""
 				evaluationSum, evaluationDetail, err := prj.BenchMark()
 				if err != nil {
-					return err
+					if prj.OnFailure == project.FailureAbort {
+						return err
+					}
+					status = project.IterationPenalized
+					if prj.OnFailure == project.FailureSkip {
+						status = project.IterationSkipped
+					}
+					fmt.Printf(" The %dth benchmark failed: %v, the iteration is %s\n", prj.StartIters, err, status)
+					evaluationSum, evaluationDetail, err = prj.Failure()
+					if err != nil {
+						return err
+					}
+				} else if prj.Violated() {
+					status = project.IterationInfeasible
+					fmt.Printf(" The %dth benchmark violates the constraints: %s, the iteration is %s\n",
+						prj.StartIters, strings.Join(prj.Violations, ","), status)
+					evaluationSum, evaluationDetail = prj.Infeasible()
 				}
 
 				currentTime := time.Now()
@@ -208,6 +329,9 @@ func profileTunning(ctx *cli.Context) error {
 						time.Duration(int64(currentTime.Sub(prj.StartsTime).Round(time.Second).Seconds())+prj.TotalTime)*time.Second,
 						prj.BestPerformance(), prj.ImproveRateString(prj.EvalMin))
 				}
+				if prj.Repeat > 1 && status == "" {
+					fmt.Printf(" The coefficient of variation of %d runs: (%s)\n", prj.Repeat, prj.Fluctuation())
+				}
 				if ctx.Bool("detail") && !prj.FeatureFilter {
 					fmt.Printf(" The %dth recommand parameters is: %s\n"+
 						" The %dth evaluation value: (%s)(%s%%)\n", prj.StartIters, prj.Params, prj.StartIters, prj.CurrPerformance(), prj.ImproveRateString(prj.EvalCurrent))
@@ -216,7 +340,7 @@ func profileTunning(ctx *cli.Context) error {
 				err = stream.Send(&PB.TuningMessage{
 					State:     PB.TuningMessage_BenchMark,
 					Content:   []byte(evaluationDetail),
-					TuningLog: &PB.TuningHistory{SumEval: evaluationSum},
+					TuningLog: &PB.TuningHistory{SumEval: evaluationSum, Status: status},
 				})
 				if err != nil {
 					return fmt.Errorf("client sends failure, error: %v", err)
@@ -231,7 +355,31 @@ func profileTunning(ctx *cli.Context) error {
 				err = stream.Send(&PB.TuningMessage{
 					State:     PB.TuningMessage_BenchMark,
 					Content:   []byte(evaluationDetail),
-					TuningLog: &PB.TuningHistory{SumEval: evaluationSum},
+					TuningLog: &PB.TuningHistory{SumEval: evaluationSum, Status: project.IterationInfeasible},
+				})
+				if err != nil {
+					return fmt.Errorf("client sends failure, error: %v", err)
+				}
+			case PB.TuningMessage_ApplyFailed:
+				if prj.OnFailure == project.FailureAbort {
+					return fmt.Errorf("the %dth parameters failed to apply: %s",
+						prj.StartIters, string(reply.GetContent()))
+				}
+				status := project.IterationPenalized
+				if prj.OnFailure == project.FailureSkip {
+					status = project.IterationSkipped
+				}
+				fmt.Printf(" The %dth parameters failed to apply: %s, the parameters are reverted and the iteration is %s\n",
+					prj.StartIters, string(reply.GetContent()), status)
+				evaluationSum, evaluationDetail, err := prj.Failure()
+				if err != nil {
+					return err
+				}
+				prj.StartIters++
+				err = stream.Send(&PB.TuningMessage{
+					State:     PB.TuningMessage_BenchMark,
+					Content:   []byte(evaluationDetail),
+					TuningLog: &PB.TuningHistory{SumEval: evaluationSum, Status: status},
 				})
 				if err != nil {
 					return fmt.Errorf("client sends failure, error: %v", err)
@@ -299,6 +447,118 @@ func checkRestoreConfig(ctx *cli.Context) error {
 	return nil
 }
 
+// readWarmStart read the history file of the warm start, nothing is read
+// if it is the id of a tuning job
+func readWarmStart(ctx *cli.Context) ([]byte, error) {
+	weight := ctx.Float64("warm-start-weight")
+	if weight < 0 || weight > 1 {
+		return nil, fmt.Errorf("error: warm-start-weight must be in [0, 1]")
+	}
+
+	warmStart := ctx.String("warm-start")
+	if warmStart == "" {
+		return nil, nil
+	}
+	exist, err := utils.PathExist(warmStart)
+	if err != nil || !exist {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(warmStart)
+	if err != nil {
+		return nil, fmt.Errorf("error: failed to read the warm start file %s: %v", warmStart, err)
+	}
+	return data, nil
+}
+
+// dryRunTuning check the server project and run the benchmark once, the
+// knobs are not changed
+func dryRunTuning(ctx *cli.Context, prj *project.YamlPrjCli) error {
+	err := sendTuningCommand(ctx, &PB.TuningMessage{Name: prj.Project, State: PB.TuningMessage_DryRun})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(" Start to benchmark once...")
+	if _, _, err := prj.BenchMark(); err != nil {
+		return fmt.Errorf("error: the benchmark failed: %v", err)
+	}
+	fmt.Printf(" The evaluations are parsed as: (%s)\n", prj.CurrPerformance())
+	if prj.Violated() {
+		fmt.Printf(" The benchmark violates the constraints: %s\n", strings.Join(prj.Violations, ","))
+	}
+	fmt.Printf(" The dry run of %s is finished, nothing is changed\n", prj.Project)
+	return nil
+}
+
+func applyTuningIteration(ctx *cli.Context) error {
+	if err := checkTuningCtx(ctx); err != nil {
+		return err
+	}
+
+	iter, err := strconv.Atoi(ctx.String("apply"))
+	if err != nil || iter < 0 {
+		return fmt.Errorf("error: the iteration to apply must be a non-negative integer")
+	}
+
+	return sendTuningCommand(ctx, &PB.TuningMessage{
+		Name:    ctx.String("project"),
+		State:   PB.TuningMessage_Apply,
+		Content: []byte(strconv.Itoa(iter)),
+	})
+}
+
+func exportTuningProfile(ctx *cli.Context) error {
+	if err := checkTuningCtx(ctx); err != nil {
+		return err
+	}
+
+	profileName := ctx.String("export-profile")
+	if len(strings.Split(profileName, "-")) != 3 {
+		return fmt.Errorf("error: the profile name must be service-app-scenario, " +
+			"and each of them must not contain '-'")
+	}
+
+	return sendTuningCommand(ctx, &PB.TuningMessage{
+		Name:    ctx.String("project"),
+		State:   PB.TuningMessage_Export,
+		Content: []byte(profileName),
+	})
+}
+
+// sendTuningCommand send the command of the project and display the reply
+// until the ending
+func sendTuningCommand(ctx *cli.Context, content *PB.TuningMessage) error {
+	err := runTuningRPC(ctx, func(stream PB.ProfileMgr_TuningClient) error {
+		if err := stream.Send(content); err != nil {
+			return fmt.Errorf("client sends failure, error: %v", err)
+		}
+
+		for {
+			reply, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			switch reply.GetState() {
+			case PB.TuningMessage_Display:
+				fmt.Printf(" %s\n", string(reply.GetContent()))
+			case PB.TuningMessage_Ending:
+				fmt.Printf(" %s\n", string(reply.GetContent()))
+				return nil
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func checkTuningCtx(ctx *cli.Context) error {
 	if ctx.String("project") == "" {
 		_ = cli.ShowCommandHelp(ctx, "tuning")
@@ -322,16 +582,124 @@ func checkTuningPrjYaml(prj *project.YamlPrjCli) error {
 		return fmt.Errorf("error: benchmark must be specified in yaml or yml")
 	}
 
+	if prj.BenchmarkTarget != "" {
+		if _, _, err := net.SplitHostPort(prj.BenchmarkTarget); err != nil {
+			return fmt.Errorf("error: benchmark_target must be host:port in project %s", prj.Project)
+		}
+	}
+
 	if len(prj.Evaluations) > 10 {
 		return fmt.Errorf("error: evaluations must be no greater than 10 "+
 			"in project %s", prj.Project)
 	}
 
+	objectives := 0
 	for _, evaluation := range prj.Evaluations {
 		if !utils.CheckValueInSlice(evaluation.Info.Type, config.EvaluationType) {
 			return fmt.Errorf("error: evaluation(%s) type must be in %v in project %s",
 				evaluation.Name, config.EvaluationType, prj.Project)
 		}
+		if evaluation.Info.Aggregate != "" &&
+			!utils.CheckValueInSlice(evaluation.Info.Aggregate, config.AggregateType) {
+			return fmt.Errorf("error: evaluation(%s) aggregate must be in %v in project %s",
+				evaluation.Name, config.AggregateType, prj.Project)
+		}
+		if evaluation.Info.Role != "" &&
+			!utils.CheckValueInSlice(evaluation.Info.Role, config.EvaluationRole) {
+			return fmt.Errorf("error: evaluation(%s) role must be in %v in project %s",
+				evaluation.Name, config.EvaluationRole, prj.Project)
+		}
+		if evaluation.Info.Extract != nil {
+			if err := evaluation.Info.Extract.Check(); err != nil {
+				return fmt.Errorf("error: evaluation(%s) extract is invalid in project %s: %v",
+					evaluation.Name, prj.Project, err)
+			}
+		} else if strings.TrimSpace(evaluation.Info.Get) == "" {
+			return fmt.Errorf("error: evaluation(%s) must have get or extract in project %s",
+				evaluation.Name, prj.Project)
+		}
+		if evaluation.Info.IsConstraint() {
+			if err := evaluation.Info.CheckBound(); err != nil {
+				return fmt.Errorf("error: evaluation(%s) bound must be a comparison such as \"< 20\" "+
+					"in project %s: %v", evaluation.Name, prj.Project, err)
+			}
+		} else {
+			objectives++
+		}
+	}
+	if objectives < 1 {
+		return fmt.Errorf("error: evaluations must have at least one objective "+
+			"in project %s", prj.Project)
+	}
+
+	if prj.Repeat < 0 {
+		return fmt.Errorf("error: repeat must be >= 0 "+
+			"in project %s", prj.Project)
+	}
+
+	if prj.MaxDuration != "" {
+		if duration, err := time.ParseDuration(prj.MaxDuration); err != nil || duration < time.Second {
+			return fmt.Errorf("error: max_duration must be a duration such as 30m or 2h "+
+				"in project %s", prj.Project)
+		}
+	}
+
+	if prj.TargetImprovement < 0 {
+		return fmt.Errorf("error: target_improvement must be >= 0 "+
+			"in project %s", prj.Project)
+	}
+
+	if prj.PlateauIters < 0 {
+		return fmt.Errorf("error: plateau_iters must be >= 0 "+
+			"in project %s", prj.Project)
+	}
+
+	if prj.BenchmarkTimeout != "" {
+		if timeout, err := time.ParseDuration(prj.BenchmarkTimeout); err != nil || timeout <= 0 {
+			return fmt.Errorf("error: benchmark_timeout must be a duration such as 90s or 10m "+
+				"in project %s", prj.Project)
+		}
+	}
+
+	if prj.BenchmarkRetries < 0 {
+		return fmt.Errorf("error: benchmark_retries must be >= 0 "+
+			"in project %s", prj.Project)
+	}
+
+	if prj.OnFailure == "" {
+		prj.OnFailure = project.FailureAbort
+	}
+	if !utils.CheckValueInSlice(prj.OnFailure, config.FailureType) {
+		return fmt.Errorf("error: on_failure must be in %v in project %s",
+			config.FailureType, prj.Project)
+	}
+	if prj.OnFailure == project.FailurePenalize {
+		for _, evaluation := range prj.Evaluations {
+			if evaluation.Info.IsConstraint() {
+				continue
+			}
+			if evaluation.Info.Penalty == nil && utils.IsEquals(evaluation.Info.Threshold, 0.0) {
+				return fmt.Errorf("error: evaluation(%s) must have penalty or threshold for "+
+					"on_failure penalize in project %s", evaluation.Name, prj.Project)
+			}
+		}
+	}
+	if prj.OnFailure == project.FailureSkip && (!optimizer.IsNative(prj.Engine) ||
+		prj.FeatureFilterCycle > 0 && !optimizer.IsNative(prj.FeatureFilterEngine)) {
+		return fmt.Errorf("error: on_failure skip needs the engine %s in project %s",
+			optimizer.BayesName, prj.Project)
+	}
+
+	if prj.ObjectiveMode == "" {
+		prj.ObjectiveMode = project.ObjectiveWeighted
+	}
+	if !utils.CheckValueInSlice(prj.ObjectiveMode, config.ObjectiveModes) {
+		return fmt.Errorf("error: objective_mode must be in %v in project %s",
+			config.ObjectiveModes, prj.Project)
+	}
+	if prj.ObjectiveMode == project.ObjectivePareto && objectives < 2 {
+		return fmt.Errorf("error: objective_mode pareto needs at least two objective evaluations "+
+			"in project %s", prj.Project)
 	}
 
 	if prj.RandomStarts < 0 {
@@ -361,8 +729,6 @@ func checkTuningPrjYaml(prj *project.YamlPrjCli) error {
 	if prj.EvalFluctuation < 0 {
 		return fmt.Errorf("error: eval_fluctuation must be >= 0 "+
 			"in project %s", prj.Project)
-	} else if prj.EvalFluctuation == 0 {
-		prj.EvalFluctuation = 0.001
 	}
 
 	if (prj.FeatureFilterEngine == "abtest" || prj.FeatureFilterEngine == "lhs" ||
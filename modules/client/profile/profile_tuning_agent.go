@@ -0,0 +1,82 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package profile
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	CTX "golang.org/x/net/context"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/client"
+)
+
+// agentExecutor : run the benchmark and evaluation scripts on the
+// load-generator host, where atuned is running in agent mode
+type agentExecutor struct {
+	target string
+	client *client.Client
+}
+
+func newAgentExecutor(target string) (*agentExecutor, error) {
+	host, port, err := net.SplitHostPort(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid benchmark_target %s: %v", target, err)
+	}
+	c, err := client.NewClient(host, port)
+	if err != nil {
+		return nil, err
+	}
+	return &agentExecutor{target: target, client: c}, nil
+}
+
+// Exec method run the script on the benchmark target and collect its output
+func (e *agentExecutor) Exec(script string, timeout time.Duration) ([]byte, error) {
+	svc := PB.NewProfileMgrClient(e.client.Connection())
+	stream, err := svc.RunBenchmark(CTX.Background(), &PB.BenchmarkMessage{
+		Script:  script,
+		Timeout: int64(timeout / time.Millisecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			return out.Bytes(), fmt.Errorf("benchmark target %s is disconnected", e.target)
+		}
+		if err != nil {
+			return out.Bytes(), err
+		}
+		out.Write(reply.GetOutput())
+		if !reply.GetDone() {
+			continue
+		}
+		if reply.GetError() != "" {
+			return out.Bytes(), fmt.Errorf("%s on benchmark target %s", reply.GetError(), e.target)
+		}
+		return out.Bytes(), nil
+	}
+}
+
+// Close method close the connection to the benchmark target
+func (e *agentExecutor) Close() {
+	e.client.Close()
+}
@@ -0,0 +1,256 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package profile
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/bndr/gotabulate"
+	"github.com/urfave/cli"
+	CTX "golang.org/x/net/context"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/client"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+var profileTuningListCommand = cli.Command{
+	Name:      "list",
+	Usage:     "list the running tuning and analysis jobs",
+	UsageText: "atune-adm tuning list",
+	Action:    profileTuningList,
+}
+
+var profileTuningPauseCommand = cli.Command{
+	Name:      "pause",
+	Usage:     "pause the running tuning job after the current benchmark",
+	UsageText: "atune-adm tuning pause <job>",
+	Action: func(ctx *cli.Context) error {
+		return controlTuningJob(ctx, "pause", "")
+	},
+}
+
+var profileTuningResumeCommand = cli.Command{
+	Name:      "resume",
+	Usage:     "resume the paused tuning job",
+	UsageText: "atune-adm tuning resume <job>",
+	Action: func(ctx *cli.Context) error {
+		return controlTuningJob(ctx, "resume", "")
+	},
+}
+
+var profileTuningStopCommand = cli.Command{
+	Name:      "stop",
+	Usage:     "stop the tuning job and apply the restore or the best configuration",
+	UsageText: "atune-adm tuning stop [--apply restore|best] <job>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "apply",
+			Usage: "the configuration applied after stop, restore or best",
+			Value: "restore",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		return controlTuningJob(ctx, "stop", ctx.String("apply"))
+	},
+}
+
+var profileTuningStatusCommand = cli.Command{
+	Name:      "status",
+	Usage:     "show the status of the running job",
+	UsageText: "atune-adm tuning status <job>",
+	Action: func(ctx *cli.Context) error {
+		return controlTuningJob(ctx, "status", "")
+	},
+}
+
+var profileTuningReportCommand = cli.Command{
+	Name:      "report",
+	Usage:     "generate the report of the last tuning of the project or the job",
+	UsageText: "atune-adm tuning report [--format markdown|html|json] [--output FILE] <project|job>",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "format,f",
+			Usage: "the format of the report, markdown, html or json",
+			Value: "markdown",
+		},
+		cli.StringFlag{
+			Name:  "output,o",
+			Usage: "the file to write the report, the report is printed if it is not set",
+			Value: "",
+		},
+	},
+	Action: profileTuningReport,
+}
+
+func profileTuningList(ctx *cli.Context) error {
+	if err := utils.CheckArgs(ctx, 0, utils.ConstExactArgs); err != nil {
+		return err
+	}
+
+	c, err := client.NewClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	svc := PB.NewProfileMgrClient(c.Connection())
+	stream, err := svc.ListJobs(CTX.Background(), &PB.ProfileInfo{})
+	if err != nil {
+		return err
+	}
+
+	table := make([][]string, 0)
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		progress := ""
+		if reply.GetMaxIteration() > 0 {
+			progress = fmt.Sprintf("%d/%d", reply.GetIteration(), reply.GetMaxIteration())
+		}
+		table = append(table, []string{reply.GetId(), reply.GetType(), reply.GetProject(),
+			reply.GetStatus(), reply.GetStartTime(), progress})
+	}
+
+	if len(table) == 0 {
+		fmt.Println("no job is in running")
+		return nil
+	}
+	tabulate := gotabulate.Create(table)
+	tabulate.SetHeaders([]string{"JobID", "Type", "Project", "Status", "StartTime", "Progress"})
+	tabulate.SetAlign("left")
+	tabulate.SetMaxCellSize(60)
+	tabulate.SetWrapStrings(true)
+	fmt.Println(tabulate.Render("grid"))
+	return nil
+}
+
+func controlTuningJob(ctx *cli.Context, action string, option string) error {
+	if err := utils.CheckArgs(ctx, 1, utils.ConstExactArgs); err != nil {
+		return err
+	}
+
+	c, err := client.NewClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	svc := PB.NewProfileMgrClient(c.Connection())
+	reply, err := svc.ControlJob(CTX.Background(), &PB.JobControl{Id: ctx.Args().Get(0),
+		Action: action, Option: option})
+	if err != nil {
+		return err
+	}
+
+	if action != "status" {
+		fmt.Printf(" %s job %s is %s\n", reply.GetType(), reply.GetId(), reply.GetStatus())
+		return nil
+	}
+	fmt.Printf(" Job: %s\n", reply.GetId())
+	fmt.Printf(" Type: %s\n", reply.GetType())
+	fmt.Printf(" Project: %s\n", reply.GetProject())
+	fmt.Printf(" Status: %s\n", reply.GetStatus())
+	fmt.Printf(" Start time: %s\n", reply.GetStartTime())
+	fmt.Printf(" Elapsed time: %s\n", time.Duration(reply.GetElapsedTime())*time.Second)
+	if reply.GetMaxIteration() > 0 {
+		fmt.Printf(" Iteration: %d/%d\n", reply.GetIteration(), reply.GetMaxIteration())
+	}
+	if reply.GetBestEval() != "" {
+		fmt.Printf(" Best evaluation: %s\n", reply.GetBestEval())
+	}
+	return nil
+}
+
+func profileTuningReport(ctx *cli.Context) error {
+	if err := utils.CheckArgs(ctx, 1, utils.ConstExactArgs); err != nil {
+		return err
+	}
+
+	c, err := client.NewClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	svc := PB.NewProfileMgrClient(c.Connection())
+	reply, err := svc.TuningReport(CTX.Background(), &PB.ReportMessage{Name: ctx.Args().Get(0),
+		Format: ctx.String("format")})
+	if err != nil {
+		return err
+	}
+
+	output := ctx.String("output")
+	if output == "" {
+		fmt.Println(string(reply.GetContent()))
+		return nil
+	}
+	if err := ioutil.WriteFile(output, reply.GetContent(), utils.FilePerm); err != nil {
+		return err
+	}
+	fmt.Printf(" The tuning report of %s is written to %s\n", reply.GetName(), output)
+	return nil
+}
+
+func attachTuningJob(ctx *cli.Context) error {
+	c, err := client.NewClientFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	svc := PB.NewProfileMgrClient(c.Connection())
+	stream, err := svc.AttachJob(CTX.Background(), &PB.ProfileInfo{Name: ctx.String("attach")})
+	if err != nil {
+		return err
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch reply.GetState() {
+		case PB.TuningMessage_BenchMark:
+			if ctx.Bool("detail") {
+				fmt.Printf(" The recommand parameters is: %s\n", string(reply.GetContent()))
+			}
+		case PB.TuningMessage_Display:
+			fmt.Printf(" %s\n", string(reply.GetContent()))
+		case PB.TuningMessage_Detail:
+			if ctx.Bool("detail") {
+				fmt.Printf(" %s\n", string(reply.GetContent()))
+			}
+		case PB.TuningMessage_Ending:
+			fmt.Printf(" %s\n", string(reply.GetContent()))
+			fmt.Printf(" Tuning Finished\n")
+			return nil
+		}
+	}
+	fmt.Println(" The job is finished")
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/project"
+	"gitee.com/openeuler/A-Tune/common/utils"
+)
+
+// benchmarkWriter : send the output of the benchmark script to the client
+type benchmarkWriter struct {
+	stream PB.ProfileMgr_RunBenchmarkServer
+}
+
+func (w *benchmarkWriter) Write(p []byte) (int, error) {
+	output := make([]byte, len(p))
+	copy(output, p)
+	if err := w.stream.Send(&PB.BenchmarkOutput{Output: output}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// RunBenchmark method run the benchmark or evaluation script of the tuning
+// client in agent mode, the output is streamed back as it is produced
+func (s *ProfileServer) RunBenchmark(message *PB.BenchmarkMessage, stream PB.ProfileMgr_RunBenchmarkServer) error {
+	if !config.Agent {
+		return fmt.Errorf("atuned is not running in agent mode, please set agent = true in atuned.cnf")
+	}
+	if err := authorizeBenchmark(stream.Context()); err != nil {
+		return err
+	}
+
+	script := message.GetScript()
+	timeout := time.Duration(message.GetTimeout()) * time.Millisecond
+	log.Infof("run the benchmark script of the tuning client: %s", script)
+	err := project.ExecWriteTimeout(script, timeout, &benchmarkWriter{stream: stream})
+	result := &PB.BenchmarkOutput{Done: true}
+	if err != nil {
+		log.Errorf("failed to run the benchmark script %s: %v", script, err)
+		result.Error = err.Error()
+	}
+	return stream.Send(result)
+}
+
+// authorizeBenchmark check the peer which runs the benchmark scripts on this
+// agent, the common name of its verified client certificate must be in
+// benchmark_allowlist
+func authorizeBenchmark(ctx context.Context) error {
+	if !config.GrpcTLS {
+		return fmt.Errorf("grpc_tls must be enabled to run the benchmark scripts in agent mode")
+	}
+	return authorizePeer(ctx, config.BenchmarkAllowlist, "benchmark_allowlist")
+}
+
+// authorizePeer check the peer is identified by its verified client
+// certificate, whose common name is in the allowlist
+func authorizePeer(ctx context.Context, allowlist []string, setting string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return fmt.Errorf("the peer of the request is unknown")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return fmt.Errorf("peer %s has no verified client certificate", p.Addr)
+	}
+
+	name := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	if !utils.CheckValueInSlice(name, allowlist) {
+		log.Warnf("reject the request of peer %s, %s is not in %s", p.Addr, name, setting)
+		return fmt.Errorf("peer %s is not in %s", name, setting)
+	}
+	log.Infof("accept the request of peer %s: %s", p.Addr, name)
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func peerContext(authInfo credentials.AuthInfo) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     &net.TCPAddr{IP: net.ParseIP("192.168.0.2"), Port: 60001},
+		AuthInfo: authInfo,
+	})
+}
+
+func verifiedPeer(name string) credentials.AuthInfo {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: name}}
+	return credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+}
+
+func TestAuthorizePeer(t *testing.T) {
+	allowlist := []string{"client1", "client2"}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		ok   bool
+	}{
+		{"allowed client", peerContext(verifiedPeer("client2")), true},
+		{"client not allowed", peerContext(verifiedPeer("client3")), false},
+		{"no peer", context.Background(), false},
+		{"no tls", peerContext(nil), false},
+		{"no verified certificate", peerContext(credentials.TLSInfo{}), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := authorizePeer(tt.ctx, allowlist, "benchmark_allowlist")
+			if tt.ok != (err == nil) {
+				t.Errorf("authorizePeer = %v, want success %v", err, tt.ok)
+			}
+		})
+	}
+}
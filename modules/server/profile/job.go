@@ -0,0 +1,264 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	PB "gitee.com/openeuler/A-Tune/api/profile"
+	"gitee.com/openeuler/A-Tune/common/config"
+	"gitee.com/openeuler/A-Tune/common/log"
+	"gitee.com/openeuler/A-Tune/common/tuning"
+)
+
+const (
+	jobTuning   = "tuning"
+	jobAnalysis = "analysis"
+
+	jobRunning = "running"
+
+	watcherBuffer = 64
+)
+
+// Job : a tuning or analysis session running in the profile server
+type Job struct {
+	sync.Mutex
+	Id           string
+	Type         string
+	Project      string
+	Knobs        []string
+	Exclusive    bool
+	StartTime    time.Time
+	Status       string
+	Iteration    int32
+	MaxIteration int32
+	BestEval     string
+	Control      *tuning.Control
+	watchers     map[chan *PB.TuningMessage]struct{}
+}
+
+// JobManager : the running jobs, jobs are rejected only when their knobs overlap
+type JobManager struct {
+	sync.Mutex
+	jobs   map[string]*Job
+	lastId int64
+}
+
+// NewJobManager method create the job manager
+func NewJobManager() *JobManager {
+	return &JobManager{jobs: make(map[string]*Job)}
+}
+
+// NewJob method create a job which is not registered yet
+func NewJob(jobType string) *Job {
+	return &Job{
+		Type:     jobType,
+		Status:   jobRunning,
+		watchers: make(map[chan *PB.TuningMessage]struct{}),
+	}
+}
+
+func (m *JobManager) newId() string {
+	id := time.Now().UnixNano() / 1e6
+	if id <= m.lastId {
+		id = m.lastId + 1
+	}
+	m.lastId = id
+	return strconv.FormatInt(id, 10)
+}
+
+// Register method give the job an id and add it to the running jobs, the job
+// continued after reboot keeps its id, an exclusive job conflicts with every
+// other job changing knobs
+func (m *JobManager) Register(job *Job, project string, knobs []string, exclusive bool) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, running := range m.jobs {
+		if !running.touchKnobs() || !exclusive && len(knobs) == 0 {
+			continue
+		}
+		if running.Exclusive || exclusive {
+			return fmt.Errorf("%s job %s of %s is in running, please wait for it to finish",
+				running.Type, running.Id, running.Project)
+		}
+		if overlap := overlapKnobs(running.Knobs, knobs); len(overlap) > 0 {
+			return fmt.Errorf("knobs %s are being tuned by job %s of %s",
+				strings.Join(overlap, ","), running.Id, running.Project)
+		}
+	}
+
+	if job.Id == "" {
+		job.Id = m.newId()
+	} else if _, ok := m.jobs[job.Id]; ok {
+		return fmt.Errorf("job %s is already in running", job.Id)
+	}
+	job.Project = project
+	job.Knobs = knobs
+	job.Exclusive = exclusive
+	job.StartTime = time.Now()
+	m.jobs[job.Id] = job
+	log.Infof("%s job %s of %s is registered", job.Type, job.Id, project)
+	return nil
+}
+
+// Remove method remove the job and stop all the watchers
+func (m *JobManager) Remove(job *Job) {
+	m.Lock()
+	if _, ok := m.jobs[job.Id]; !ok {
+		m.Unlock()
+		return
+	}
+	delete(m.jobs, job.Id)
+	m.Unlock()
+
+	job.Lock()
+	for ch := range job.watchers {
+		close(ch)
+		delete(job.watchers, ch)
+	}
+	job.Unlock()
+	log.Infof("%s job %s of %s is finished", job.Type, job.Id, job.Project)
+}
+
+// Get method return the running job by id
+func (m *JobManager) Get(id string) (*Job, error) {
+	m.Lock()
+	defer m.Unlock()
+	job, ok := m.jobs[strings.TrimSpace(id)]
+	if !ok {
+		return nil, fmt.Errorf("job %s is not in running", id)
+	}
+	return job, nil
+}
+
+// List method return the running jobs sorted by start time
+func (m *JobManager) List() []*Job {
+	m.Lock()
+	defer m.Unlock()
+	jobs := make([]*Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].Id < jobs[j].Id })
+	return jobs
+}
+
+func (j *Job) touchKnobs() bool {
+	return j.Exclusive || len(j.Knobs) > 0
+}
+
+func overlapKnobs(running []string, knobs []string) []string {
+	names := make(map[string]struct{}, len(running))
+	for _, name := range running {
+		names[name] = struct{}{}
+	}
+	overlap := make([]string, 0)
+	for _, name := range knobs {
+		if _, ok := names[name]; ok {
+			overlap = append(overlap, name)
+		}
+	}
+	return overlap
+}
+
+// SetProgress method record the iteration and the best evaluation of the job
+func (j *Job) SetProgress(iteration int32, maxIteration int32, bestEval string) {
+	j.Lock()
+	defer j.Unlock()
+	j.Iteration = iteration
+	j.MaxIteration = maxIteration
+	j.BestEval = bestEval
+}
+
+// Info method return the job info send to the client
+func (j *Job) Info() *PB.JobInfo {
+	j.Lock()
+	defer j.Unlock()
+	status := j.Status
+	if j.Control != nil {
+		status = j.Control.Status()
+	}
+	return &PB.JobInfo{
+		Id:           j.Id,
+		Type:         j.Type,
+		Project:      j.Project,
+		Status:       status,
+		StartTime:    j.StartTime.Format(config.DefaultTimeFormat),
+		Iteration:    j.Iteration,
+		MaxIteration: j.MaxIteration,
+		Knobs:        strings.Join(j.Knobs, ","),
+		BestEval:     j.BestEval,
+		ElapsedTime:  int64(time.Since(j.StartTime).Seconds()),
+	}
+}
+
+// Watch method return the channel receiving the messages of the job
+func (j *Job) Watch() chan *PB.TuningMessage {
+	j.Lock()
+	defer j.Unlock()
+	ch := make(chan *PB.TuningMessage, watcherBuffer)
+	j.watchers[ch] = struct{}{}
+	return ch
+}
+
+// Unwatch method stop the watcher
+func (j *Job) Unwatch(ch chan *PB.TuningMessage) {
+	j.Lock()
+	defer j.Unlock()
+	if _, ok := j.watchers[ch]; ok {
+		close(ch)
+		delete(j.watchers, ch)
+	}
+}
+
+// Broadcast method send the message to the watchers, a slow watcher
+// drops messages instead of blocking the job
+func (j *Job) Broadcast(message *PB.TuningMessage) {
+	j.Lock()
+	defer j.Unlock()
+	for ch := range j.watchers {
+		select {
+		case ch <- message:
+		default:
+		}
+	}
+}
+
+// jobTuningStream : the tuning stream which copies the messages to the job watchers
+type jobTuningStream struct {
+	PB.ProfileMgr_TuningServer
+	job *Job
+}
+
+func (s *jobTuningStream) Send(message *PB.TuningMessage) error {
+	s.job.Broadcast(message)
+	return s.ProfileMgr_TuningServer.Send(message)
+}
+
+// jobAnalysisStream : the analysis stream which copies the messages to the job watchers
+type jobAnalysisStream struct {
+	PB.ProfileMgr_AnalysisServer
+	job *Job
+}
+
+func (s *jobAnalysisStream) Send(message *PB.AckCheck) error {
+	s.job.Broadcast(&PB.TuningMessage{State: PB.TuningMessage_Display, Content: []byte(message.GetName())})
+	return s.ProfileMgr_AnalysisServer.Send(message)
+}
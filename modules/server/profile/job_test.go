@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package main
+
+import (
+	"testing"
+)
+
+type testJob struct {
+	project   string
+	knobs     []string
+	exclusive bool
+}
+
+func TestJobManagerRegister(t *testing.T) {
+	tests := []struct {
+		name    string
+		running []testJob
+		job     testJob
+		ok      bool
+	}{
+		{"no running jobs", nil, testJob{"a", []string{"x", "y"}, false}, true},
+		{"disjoint knobs", []testJob{{"a", []string{"x", "y"}, false}},
+			testJob{"b", []string{"z"}, false}, true},
+		{"overlapping knobs", []testJob{{"a", []string{"x", "y"}, false}},
+			testJob{"b", []string{"y", "z"}, false}, false},
+		{"exclusive job with knobs running", []testJob{{"a", []string{"x"}, false}},
+			testJob{"b", nil, true}, false},
+		{"job with knobs while exclusive running", []testJob{{"a", nil, true}},
+			testJob{"b", []string{"z"}, false}, false},
+		{"job without knobs while exclusive running", []testJob{{"a", nil, true}},
+			testJob{"b", nil, false}, true},
+		{"exclusive job while analysis running", []testJob{{"a", nil, false}},
+			testJob{"b", nil, true}, true},
+		{"exclusive jobs", []testJob{{"a", nil, true}}, testJob{"b", nil, true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewJobManager()
+			for _, running := range tt.running {
+				if err := m.Register(NewJob(jobTuning), running.project, running.knobs, running.exclusive); err != nil {
+					t.Fatalf("Register of the running job failed: %v", err)
+				}
+			}
+			job := NewJob(jobTuning)
+			err := m.Register(job, tt.job.project, tt.job.knobs, tt.job.exclusive)
+			if tt.ok != (err == nil) {
+				t.Fatalf("Register = %v, want success %v", err, tt.ok)
+			}
+			if tt.ok && (job.Id == "" || len(m.List()) != len(tt.running)+1) {
+				t.Errorf("the job is not registered, id %q, %d jobs", job.Id, len(m.List()))
+			}
+			if !tt.ok && len(m.List()) != len(tt.running) {
+				t.Errorf("the rejected job is registered")
+			}
+		})
+	}
+}
+
+func TestJobManagerIds(t *testing.T) {
+	m := NewJobManager()
+	first, second := NewJob(jobTuning), NewJob(jobAnalysis)
+	if err := m.Register(first, "a", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Register(second, "b", nil, false); err != nil {
+		t.Fatal(err)
+	}
+	if first.Id == second.Id {
+		t.Errorf("the jobs have the same id %s", first.Id)
+	}
+	if job, err := m.Get(" " + second.Id + " "); err != nil || job != second {
+		t.Errorf("Get of job %s = %v, %v", second.Id, job, err)
+	}
+
+	continued := NewJob(jobTuning)
+	continued.Id = first.Id
+	if err := m.Register(continued, "a", nil, false); err == nil {
+		t.Errorf("Register of the running id %s succeeded", first.Id)
+	}
+
+	m.Remove(first)
+	if _, err := m.Get(first.Id); err == nil {
+		t.Errorf("the removed job %s is still running", first.Id)
+	}
+	if err := m.Register(continued, "a", nil, false); err != nil || continued.Id != first.Id {
+		t.Errorf("the job continued after reboot does not keep its id: %s, %v", continued.Id, err)
+	}
+}
@@ -0,0 +1,30 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"gitee.com/openeuler/A-Tune/common/config"
+)
+
+// authorizeNode check the peer which sets or gets the knobs of this node, the
+// common name of its verified client certificate must be in node_allowlist
+func authorizeNode(ctx context.Context) error {
+	if !config.GrpcTLS {
+		return fmt.Errorf("grpc_tls must be enabled to set or get the knobs by the other nodes")
+	}
+	return authorizePeer(ctx, config.NodeAllowlist, "node_allowlist")
+}
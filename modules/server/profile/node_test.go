@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2026 Huawei Technologies Co., Ltd.
+ * A-Tune is licensed under the Mulan PSL v2.
+ * You can use this software according to the terms and conditions of the Mulan PSL v2.
+ * You may obtain a copy of Mulan PSL v2 at:
+ *     http://license.coscl.org.cn/MulanPSL2
+ * THIS SOFTWARE IS PROVIDED ON AN "AS IS" BASIS, WITHOUT WARRANTIES OF ANY KIND, EITHER EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO NON-INFRINGEMENT, MERCHANTABILITY OR FIT FOR A PARTICULAR
+ * PURPOSE.
+ * See the Mulan PSL v2 for more details.
+ * Create: 2026-10-17
+ */
+
+package main
+
+import (
+	"testing"
+
+	"gitee.com/openeuler/A-Tune/common/config"
+)
+
+func TestAuthorizeNode(t *testing.T) {
+	tests := []struct {
+		name    string
+		grpcTLS bool
+		node    string
+		ok      bool
+	}{
+		{"allowed node", true, "node2", true},
+		{"node not allowed", true, "node3", false},
+		{"grpc_tls disabled", false, "node2", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.GrpcTLS = tt.grpcTLS
+			config.NodeAllowlist = []string{"node1", "node2"}
+			defer func() {
+				config.GrpcTLS = false
+				config.NodeAllowlist = nil
+			}()
+			err := authorizeNode(peerContext(verifiedPeer(tt.node)))
+			if tt.ok != (err == nil) {
+				t.Errorf("authorizeNode = %v, want success %v", err, tt.ok)
+			}
+		})
+	}
+}
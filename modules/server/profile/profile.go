@@ -91,10 +91,10 @@ type RespClassify struct {
 
 // ProfileServer : the type impletent the grpc server
 type ProfileServer struct {
-	utils.MutexLock
 	ConfPath   string
 	ScriptPath string
 	Raw        *ini.File
+	Jobs       *JobManager
 }
 
 func init() {
@@ -128,11 +128,44 @@ func NewProfileServer(ctx *cli.Context, opts ...interface{}) (interface{}, error
 		return nil, fmt.Errorf("failed to parse %s, %v", defaultConfigFile, err)
 	}
 
+	runs, err := sqlstore.InterruptTuningRuns()
+	if err != nil {
+		log.Errorf("failed to find the interrupted tuning runs: %v", err)
+	}
+	for _, run := range runs {
+		log.Warnf("tuning job %s of project %s was interrupted, use "+
+			"atune-adm tuning --attach %s PROJECT_YAML to continue it", run.JobID, run.Project, run.JobID)
+	}
+
 	return &ProfileServer{
-		Raw: cfg,
+		Raw:  cfg,
+		Jobs: NewJobManager(),
 	}, nil
 }
 
+// Ready method reapply the params of the tuning jobs waiting to continue
+// after reboot, before atuned notifies systemd that it is ready
+func (s *ProfileServer) Ready() error {
+	states, err := tuning.RebootStates()
+	if err != nil {
+		return err
+	}
+	for _, state := range states {
+		optimizer := tuning.Optimizer{}
+		if err := tuning.CheckServerPrj(state.Project, &optimizer); err != nil {
+			log.Errorf("failed to load project %s of job %s: %v", state.Project, state.JobID, err)
+			continue
+		}
+		if err := optimizer.ReapplyReboot(state); err != nil {
+			log.Errorf("failed to reapply the params of job %s after reboot: %v", state.JobID, err)
+			continue
+		}
+		log.Warnf("tuning job %s of project %s is waiting to continue after reboot, use "+
+			"atune-adm tuning --attach %s PROJECT_YAML to continue it", state.JobID, state.Project, state.JobID)
+	}
+	return nil
+}
+
 // RegisterServer method register the grpc service
 func (s *ProfileServer) RegisterServer(server *grpc.Server) error {
 	PB.RegisterProfileMgrServer(server, s)
@@ -433,12 +466,12 @@ func (s *ProfileServer) Analysis(message *PB.AnalysisMessage, stream PB.ProfileM
 		collectStatus[id] = "run"
 	}
 
-	if !message.Characterization {
-		if !s.TryLock() {
-			return fmt.Errorf("dynamic optimizer search or analysis has been in running")
-		}
-		defer s.Unlock()
+	job := NewJob(jobAnalysis)
+	if err := s.Jobs.Register(job, message.GetName(), nil, !message.Characterization); err != nil {
+		return err
 	}
+	defer s.Jobs.Remove(job)
+	stream = &jobAnalysisStream{ProfileMgr_AnalysisServer: stream, job: job}
 	_ = stream.Send(&PB.AckCheck{Name: "1. Analysis system runtime information: CPU Memory IO and Network..."})
 
 	npipe, err := utils.CreateNamedPipe()
@@ -644,25 +677,39 @@ func (s *ProfileServer) Analysis(message *PB.AnalysisMessage, stream PB.ProfileM
 
 // Tuning method calling the bayes search method to tuned parameters
 func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
-	if !s.TryLock() {
-		return fmt.Errorf("dynamic optimizer search or analysis has been in running")
-	}
-	defer s.Unlock()
+	job := NewJob(jobTuning)
+	job.Control = tuning.NewControl()
+	defer s.Jobs.Remove(job)
+	stream = &jobTuningStream{ProfileMgr_TuningServer: stream, job: job}
+	go func() {
+		<-stream.Context().Done()
+		job.Control.Cancel()
+	}()
 
 	ch := make(chan *PB.TuningMessage)
-	defer close(ch)
+	done := make(chan struct{})
+	defer func() {
+		close(ch)
+		<-done
+	}()
 	go func() {
+		defer close(done)
 		for value := range ch {
 			_ = stream.Send(value)
 		}
 	}()
 
-	var optimizer = tuning.Optimizer{}
+	var optimizer = tuning.Optimizer{Control: job.Control}
 	defer func() {
 		if err := optimizer.DeleteTask(); err != nil {
 			log.Errorf("delete optimizer task failed, error: %v", err)
 		}
 	}()
+	defer func() {
+		if stream.Context().Err() != nil {
+			optimizer.Disconnect(ch)
+		}
+	}()
 
 	stopCh := make(chan int, 1)
 	defer close(stopCh)
@@ -673,13 +720,17 @@ func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
 	for {
 		select {
 		case stop := <-stopCh:
+			if stop == tuning.StopJob {
+				return nil
+			}
 			if cycles > 0 {
 				if stop == 2 {
 					cycles = 1
 				}
 				_ = stream.Send(&PB.TuningMessage{State: PB.TuningMessage_JobRestart})
 			} else {
-				_ = stream.Send(&PB.TuningMessage{State: PB.TuningMessage_Ending})
+				_ = stream.Send(&PB.TuningMessage{State: PB.TuningMessage_Ending,
+					Content: []byte(optimizer.ParetoMessage())})
 			}
 			cycles--
 		default:
@@ -698,12 +749,18 @@ func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
 		state := reply.GetState()
 		switch state {
 		case PB.TuningMessage_SyncConfig:
+			if err = authorizeNode(stream.Context()); err != nil {
+				return err
+			}
 			optimizer.Content = reply.GetContent()
 			err = optimizer.SyncTunedNode(ch)
 			if err != nil {
 				return err
 			}
 		case PB.TuningMessage_GetInitialConfig:
+			if err = authorizeNode(stream.Context()); err != nil {
+				return err
+			}
 			optimizer.Content = reply.GetContent()
 			err = optimizer.GetNodeInitialConfig(ch)
 			if err != nil {
@@ -730,6 +787,27 @@ func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
 			}
 		case PB.TuningMessage_JobInit:
 			project := reply.GetName()
+			rebootState, err := tuning.GetRebootState(reply.GetId())
+			if err != nil {
+				return err
+			}
+			var interrupted *sqlstore.TuningRun
+			if reply.GetId() != "" && rebootState == nil {
+				interrupted, err = sqlstore.GetJobTuningRun(reply.GetId())
+				if err != nil {
+					return err
+				}
+				if interrupted == nil || interrupted.Status != sqlstore.TuningInterrupted {
+					return fmt.Errorf("job %s is not waiting to continue after reboot or interruption",
+						reply.GetId())
+				}
+				project = interrupted.Project
+				job.Id = interrupted.JobID
+			}
+			if rebootState != nil {
+				project = rebootState.Project
+				job.Id = rebootState.JobID
+			}
 			if len(strings.TrimSpace(project)) == 0 {
 				if err != nil {
 					return err
@@ -753,6 +831,9 @@ func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
 			if err = tuning.CheckServerPrj(project, &optimizer); err != nil {
 				return err
 			}
+			if err = s.Jobs.Register(job, optimizer.Prj.Project, optimizer.Prj.Knobs(), false); err != nil {
+				return err
+			}
 
 			optimizer.Engine = reply.GetEngine()
 			optimizer.Content = reply.GetContent()
@@ -761,12 +842,40 @@ func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
 			optimizer.FeatureFilterEngine = reply.GetFeatureFilterEngine()
 			optimizer.FeatureFilterIters = reply.GetFeatureFilterIters()
 			optimizer.SplitCount = reply.GetSplitCount()
-			optimizer.PrjId = strconv.FormatInt(time.Now().UnixNano()/1e6, 10)
+			optimizer.PrjId = job.Id
 			cycles = reply.GetFeatureFilterCycle()
 			optimizer.FeatureFilterCount = reply.GetFeatureFilterCount()
 			optimizer.EvalFluctuation = reply.GetEvalFluctuation()
 			optimizer.FeatureSelector = reply.GetFeatureSelector()
-			ch <- &PB.TuningMessage{State: PB.TuningMessage_JobCreate}
+			optimizer.StartTime = job.StartTime
+			optimizer.MaxDuration = time.Duration(reply.GetMaxDuration()) * time.Second
+			optimizer.TargetImprovement = reply.GetTargetImprovement()
+			optimizer.PlateauIters = reply.GetPlateauIters()
+			optimizer.PlateauImprovement = reply.GetPlateauImprovement()
+			optimizer.ObjectiveMode = reply.GetObjectiveMode()
+			optimizer.Objectives = reply.GetObjectives()
+			optimizer.WarmStart = reply.GetWarmStart()
+			optimizer.WarmStartData = reply.GetWarmStartData()
+			optimizer.WarmStartWeight = reply.GetWarmStartWeight()
+			if rebootState != nil {
+				message = fmt.Sprintf("%d.Continue the tuning after reboot......", step)
+				step += 1
+				ch <- &PB.TuningMessage{State: PB.TuningMessage_Display, Content: []byte(message)}
+				if err = optimizer.Resume(rebootState); err != nil {
+					return err
+				}
+				cycles = 0
+			}
+			if interrupted != nil {
+				message = fmt.Sprintf("%d.Continue the interrupted tuning......", step)
+				step += 1
+				ch <- &PB.TuningMessage{State: PB.TuningMessage_Display, Content: []byte(message)}
+				if err = optimizer.ResumeInterrupted(interrupted); err != nil {
+					return err
+				}
+				cycles = 0
+			}
+			ch <- &PB.TuningMessage{State: PB.TuningMessage_JobCreate, Id: job.Id}
 		case PB.TuningMessage_JobCreate:
 			optimizer.EvalBase = reply.GetTuningLog().GetBaseEval()
 			optimizer.Evaluations = reply.GetTuningLog().GetSumEval()
@@ -797,25 +906,174 @@ func (s *ProfileServer) Tuning(stream PB.ProfileMgr_TuningServer) error {
 			if err := tuning.CheckServerPrj(project, &optimizer); err != nil {
 				return err
 			}
+			if err := s.Jobs.Register(job, optimizer.Prj.Project, optimizer.Prj.Knobs(), false); err != nil {
+				return err
+			}
 			if err := optimizer.RestoreConfigTuned(ch); err != nil {
 				return err
 			}
 			log.Infof("restore project %s success", project)
 			return nil
+		case PB.TuningMessage_Export:
+			project := reply.GetName()
+			profileName := string(reply.GetContent())
+			isLocalAddr, err := SVC.CheckRpcIsLocalAddr(stream.Context())
+			if err != nil {
+				return err
+			}
+			if !isLocalAddr {
+				return fmt.Errorf("the export profile command can not be remotely operated")
+			}
+			names := strings.Split(profileName, "-")
+			if len(names) != 3 {
+				return fmt.Errorf("the profile name %s must be service-app-scenario", profileName)
+			}
+			log.Infof("begin to export project %s to profile %s", project, profileName)
+			if err := tuning.CheckServerPrj(project, &optimizer); err != nil {
+				return err
+			}
+			content, err := optimizer.ExportProfile(profileName)
+			if err != nil {
+				return err
+			}
+			_, exist, err := defineProfile(names[0], names[1], names[2], content)
+			if err != nil {
+				return err
+			}
+			if exist {
+				return fmt.Errorf("%s is already exist", profileName)
+			}
+			message := fmt.Sprintf("export the best result of %s to profile %s success, "+
+				"activate it by: atune-adm profile %s", project, profileName, profileName)
+			ch <- &PB.TuningMessage{State: PB.TuningMessage_Ending, Content: []byte(message)}
+			return nil
+		case PB.TuningMessage_DryRun:
+			project := reply.GetName()
+			log.Infof("begin to dry run project: %s", project)
+			if err := tuning.CheckServerPrj(project, &optimizer); err != nil {
+				return err
+			}
+			return optimizer.DryRun(ch)
+		case PB.TuningMessage_Apply:
+			project := reply.GetName()
+			iter, err := strconv.Atoi(string(reply.GetContent()))
+			if err != nil {
+				return fmt.Errorf("invalid iteration %s", string(reply.GetContent()))
+			}
+			log.Infof("begin to apply iteration %d of project: %s", iter, project)
+			if err := tuning.CheckServerPrj(project, &optimizer); err != nil {
+				return err
+			}
+			if err := s.Jobs.Register(job, optimizer.Prj.Project, optimizer.Prj.Knobs(), false); err != nil {
+				return err
+			}
+			return optimizer.ApplyIteration(ch, iter)
 		case PB.TuningMessage_BenchMark:
 			optimizer.Content = reply.GetContent()
 			optimizer.Evaluations = reply.GetTuningLog().GetSumEval()
+			optimizer.IterStatus = reply.GetTuningLog().GetStatus()
 			err := optimizer.DynamicTuned(ch, stopCh)
 			if err != nil {
 				return err
 			}
 
 		}
+		job.SetProgress(int32(optimizer.Iter), optimizer.MaxIter,
+			tuning.DisplayEval(optimizer.FinalEval))
 	}
 
 	return nil
 }
 
+// ListJobs method list the running tuning and analysis jobs
+func (s *ProfileServer) ListJobs(profileInfo *PB.ProfileInfo, stream PB.ProfileMgr_ListJobsServer) error {
+	for _, job := range s.Jobs.List() {
+		if err := stream.Send(job.Info()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AttachJob method send the messages of the running job to the client
+func (s *ProfileServer) AttachJob(profileInfo *PB.ProfileInfo, stream PB.ProfileMgr_AttachJobServer) error {
+	job, err := s.Jobs.Get(profileInfo.GetName())
+	if err != nil {
+		if state, _ := tuning.GetRebootState(profileInfo.GetName()); state != nil {
+			return fmt.Errorf("job %s of %s is waiting to continue after reboot, please attach it "+
+				"with the project yaml: atune-adm tuning --attach %s PROJECT_YAML",
+				state.JobID, state.Project, state.JobID)
+		}
+		return err
+	}
+
+	ch := job.Watch()
+	defer job.Unwatch(ch)
+	info := job.Info()
+	message := fmt.Sprintf("attached to %s job %s of %s, started at %s", info.Type, info.Id,
+		info.Project, info.StartTime)
+	if err := stream.Send(&PB.TuningMessage{State: PB.TuningMessage_Display, Content: []byte(message), Id: info.Id}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case value, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(value); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// ControlJob method pause, resume or stop the running tuning job, or show its status
+func (s *ProfileServer) ControlJob(ctx context.Context, message *PB.JobControl) (*PB.JobInfo, error) {
+	job, err := s.Jobs.Get(message.GetId())
+	if err != nil {
+		return &PB.JobInfo{}, err
+	}
+
+	action := message.GetAction()
+	if action != "status" && job.Control == nil {
+		return &PB.JobInfo{}, fmt.Errorf("%s job %s does not support %s", job.Type, job.Id, action)
+	}
+	switch action {
+	case "pause":
+		err = job.Control.Pause()
+	case "resume":
+		err = job.Control.Resume()
+	case "stop":
+		err = job.Control.Stop(message.GetOption())
+	case "status":
+	default:
+		err = fmt.Errorf("unknown action %s of job %s", action, job.Id)
+	}
+	if err != nil {
+		return &PB.JobInfo{}, err
+	}
+	log.Infof("%s job %s of %s: %s %s", job.Type, job.Id, job.Project, action, message.GetOption())
+	return job.Info(), nil
+}
+
+// TuningReport method generate the report of the tuning job or project from the history
+func (s *ProfileServer) TuningReport(ctx context.Context, message *PB.ReportMessage) (*PB.ProfileInfo, error) {
+	report, err := tuning.NewReport(message.GetName())
+	if err != nil {
+		return &PB.ProfileInfo{}, err
+	}
+	content, err := report.Format(message.GetFormat())
+	if err != nil {
+		return &PB.ProfileInfo{}, err
+	}
+	log.Infof("generate the %s tuning report of %s", message.GetFormat(), message.GetName())
+	return &PB.ProfileInfo{Name: report.Project, Content: content}, nil
+}
+
 /*
 UpgradeProfile method update the db file
 */
@@ -1211,61 +1469,73 @@ func (s *ProfileServer) Define(ctx context.Context, message *PB.DefineMessage) (
 		return &PB.Ack{}, fmt.Errorf("the define command can not be remotely operated")
 	}
 
-	serviceType := message.GetServiceType()
-	applicationName := message.GetApplicationName()
-	scenarioName := message.GetScenarioName()
-	content := string(message.GetContent())
+	profileName, exist, err := defineProfile(message.GetServiceType(), message.GetApplicationName(),
+		message.GetScenarioName(), string(message.GetContent()))
+	if err != nil {
+		return &PB.Ack{}, err
+	}
+	if exist {
+		return &PB.Ack{Status: fmt.Sprintf("%s is already exist", profileName)}, nil
+	}
+
+	return &PB.Ack{Status: "OK"}, nil
+}
+
+// defineProfile register the self define workload type and write the
+// profile, it returns true if the profile is already exist
+func defineProfile(serviceType string, applicationName string, scenarioName string,
+	content string) (string, bool, error) {
 	profileName := serviceType + "-" + applicationName + "-" + scenarioName
 
 	workloadTypeExist, err := sqlstore.ExistWorkloadType(profileName)
 	if err != nil {
-		return &PB.Ack{}, err
+		return profileName, false, err
 	}
 	if !workloadTypeExist {
 		if err = sqlstore.InsertClassApps(&sqlstore.ClassApps{
 			Class:     profileName,
 			Apps:      profileName,
 			Deletable: true}); err != nil {
-			return &PB.Ack{}, err
+			return profileName, false, err
 		}
 	}
 
 	profileNameExist, err := sqlstore.ExistProfileName(profileName)
 	if err != nil {
-		return &PB.Ack{}, err
+		return profileName, false, err
 	}
 	if !profileNameExist {
 		if err = sqlstore.InsertClassProfile(&sqlstore.ClassProfile{
 			Class:       profileName,
 			ProfileType: profileName,
 			Active:      false}); err != nil {
-			return &PB.Ack{}, err
+			return profileName, false, err
 		}
 	}
 
 	profileExist, err := profile.ExistProfile(profileName)
 	if err != nil {
-		return &PB.Ack{}, err
+		return profileName, false, err
 	}
 
 	if profileExist {
-		return &PB.Ack{Status: fmt.Sprintf("%s is already exist", profileName)}, nil
+		return profileName, true, nil
 	}
 
 	dstPath := path.Join(config.DefaultProfilePath, serviceType, applicationName)
 	err = utils.CreateDir(dstPath, utils.FilePerm)
 	if err != nil {
-		return &PB.Ack{}, err
+		return profileName, false, err
 	}
 
 	dstFile := path.Join(dstPath, fmt.Sprintf("%s.conf", scenarioName))
 	err = utils.WriteFile(dstFile, content, utils.FilePerm, os.O_WRONLY|os.O_CREATE)
 	if err != nil {
 		log.Error(err)
-		return &PB.Ack{}, err
+		return profileName, false, err
 	}
 
-	return &PB.Ack{Status: "OK"}, nil
+	return profileName, false, nil
 }
 
 // Delete method delete the self define workload type from database